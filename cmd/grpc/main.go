@@ -11,10 +11,11 @@ func main() {
 	db := config.NewDatabase(viperConfig, log)
 	validate := config.NewValidator(viperConfig)
 	elasticsearch := config.NewElasticSearch(viperConfig, log)
+	redis := config.NewRedis(viperConfig, log)
 
-	if !command.NewCommandExecutor(viperConfig, db).Execute(log) {
+	if !command.NewCommandExecutor(viperConfig, db, validate, elasticsearch).Execute(log) {
 		return
 	}
 
-	config.StartGRPC(viperConfig, db, validate, log, elasticsearch)
+	config.StartGRPC(viperConfig, db, validate, log, elasticsearch, redis)
 }
@@ -1,9 +1,16 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"golectro-product/internal/command"
 	"golectro-product/internal/config"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 func main() {
@@ -17,9 +24,9 @@ func main() {
 	app := config.NewGin(viper, log, mongo, redis)
 	minio := config.NewMinioClient(viper, log)
 	elasticsearch := config.NewElasticSearch(viper, log)
-	executor := command.NewCommandExecutor(viper, db)
+	executor := command.NewCommandExecutor(viper, db, validate, elasticsearch)
 
-	config.Bootstrap(&config.BootstrapConfig{
+	productScheduler := config.Bootstrap(&config.BootstrapConfig{
 		Viper:    viper,
 		Log:      log,
 		DB:       db,
@@ -36,11 +43,27 @@ func main() {
 		return
 	}
 
-	go config.StartGRPC(viper, db, validate, log, elasticsearch)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go config.StartGRPC(viper, db, validate, log, elasticsearch, redis)
+	go productScheduler.Start(ctx)
 
 	webPort := viper.GetInt("PORT")
-	err := app.Run(fmt.Sprintf(":%d", webPort))
-	if err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	server := &http.Server{Addr: fmt.Sprintf(":%d", webPort), Handler: app}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+	log.Info("Shutting down server")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.WithError(err).Error("Failed to shut down server")
 	}
 }
@@ -1,25 +1,37 @@
 package command
 
 import (
+	"context"
 	"fmt"
+	"golectro-product/internal/config"
 	"golectro-product/internal/migrations"
+	"golectro-product/internal/model"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/usecase"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/go-playground/validator/v10"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"gorm.io/gorm"
 )
 
 type CommandExecutor struct {
-	DB    *gorm.DB
-	Viper *viper.Viper
+	DB       *gorm.DB
+	Viper    *viper.Viper
+	Validate *validator.Validate
+	Elastic  *elasticsearch.Client
 }
 
-func NewCommandExecutor(viper *viper.Viper, db *gorm.DB) *CommandExecutor {
+func NewCommandExecutor(viper *viper.Viper, db *gorm.DB, validate *validator.Validate, elastic *elasticsearch.Client) *CommandExecutor {
 	return &CommandExecutor{
-		DB:    db,
-		Viper: viper,
+		DB:       db,
+		Viper:    viper,
+		Validate: validate,
+		Elastic:  elastic,
 	}
 }
 
@@ -42,6 +54,16 @@ func (ce *CommandExecutor) Execute(logger *logrus.Logger) bool {
 			ce.handleDropDB(logger)
 		case "--drop-table":
 			ce.handleDropTable(logger)
+		case "--dedup-brands":
+			ce.handleDedupBrands(logger)
+		case "--purge-deleted":
+			ce.handlePurgeDeleted(logger)
+		case "--gc-images":
+			ce.handleGCImages(logger)
+		case "--reindex-products":
+			ce.handleReindexProducts(logger)
+		case "--group-duplicate-images":
+			ce.handleGroupDuplicateImages(logger)
 		case "--run":
 			run = true
 		}
@@ -64,6 +86,113 @@ func (ce *CommandExecutor) handleSeed(logger *logrus.Logger) {
 	logger.Println("✅ Seeder completed")
 }
 
+func (ce *CommandExecutor) handleDedupBrands(logger *logrus.Logger) {
+	products, err := migrations.DeduplicateBrands(ce.DB, logger)
+	if err != nil {
+		logger.Fatalf("❌ Brand deduplication failed: %v", err)
+	}
+
+	elasticsearchUseCase := usecase.NewElasticsearchUsecase(ce.Elastic, logger, ce.Validate, ce.Viper)
+	for i := range products {
+		if err := elasticsearchUseCase.InsertDocument(products[i].ID, &products[i]); err != nil {
+			logger.Warnf("⚠️ Failed to reindex product %s: %v", products[i].ID, err)
+		}
+	}
+	logger.Printf("✅ Brand deduplication completed, %d products linked\n", len(products))
+}
+
+func (ce *CommandExecutor) handlePurgeDeleted(logger *logrus.Logger) {
+	retentionDays := ce.Viper.GetInt("PURGE_RETENTION_DAYS")
+	if retentionDays <= 0 {
+		retentionDays = 30
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -retentionDays)
+	products, err := migrations.PurgeDeletedProducts(ce.DB, cutoff)
+	if err != nil {
+		logger.Fatalf("❌ Purging deleted products failed: %v", err)
+	}
+
+	if len(products) > 0 {
+		bucket := ce.Viper.GetString("MINIO_BUCKET_PRODUCT")
+		minioUseCase := usecase.NewMinioUsecase(repository.NewMinioRepository(config.NewMinioClient(ce.Viper, logger)), ce.Validate, logger)
+		renditionUseCase := usecase.NewImageRenditionUsecase(logger, ce.Validate, ce.Viper, minioUseCase)
+		for _, product := range products {
+			for _, image := range product.Images {
+				if err := minioUseCase.Delete(context.Background(), bucket, image.ImageObject); err != nil {
+					logger.Warnf("⚠️ Failed to delete image %s of product %s: %v", image.ImageObject, product.ID, err)
+				}
+				if err := renditionUseCase.DeleteRenditions(context.Background(), image.ImageObject); err != nil {
+					logger.Warnf("⚠️ Failed to delete renditions of image %s: %v", image.ImageObject, err)
+				}
+			}
+		}
+	}
+	logger.Printf("✅ Purged %d products deleted before %s\n", len(products), cutoff.Format(time.DateOnly))
+}
+
+func (ce *CommandExecutor) handleGCImages(logger *logrus.Logger) {
+	grace := model.ImageGCGracePeriod
+	if hours := ce.Viper.GetInt("GC_IMAGES_GRACE_HOURS"); hours > 0 {
+		grace = time.Duration(hours) * time.Hour
+	}
+
+	minioUseCase := usecase.NewMinioUsecase(repository.NewMinioRepository(config.NewMinioClient(ce.Viper, logger)), ce.Validate, logger)
+	elasticsearchUseCase := usecase.NewElasticsearchUsecase(ce.Elastic, logger, ce.Validate, ce.Viper)
+	imageUseCase := usecase.NewImageUsecase(ce.DB, logger, ce.Validate, ce.Viper, repository.NewImageRepository(logger), repository.NewProductRepository(logger), repository.NewImageUploadRepository(logger), minioUseCase, elasticsearchUseCase, &repository.NoopFileScanner{})
+
+	cutoff := time.Now().Add(-grace)
+	removed, err := imageUseCase.CollectOrphanedImages(context.Background(), cutoff)
+	if err != nil {
+		logger.Fatalf("❌ Image garbage collection failed: %v", err)
+	}
+	logger.Printf("✅ Removed %d orphaned image objects older than %s\n", removed, cutoff.Format(time.RFC3339))
+}
+
+func (ce *CommandExecutor) handleGroupDuplicateImages(logger *logrus.Logger) {
+	minioUseCase := usecase.NewMinioUsecase(repository.NewMinioRepository(config.NewMinioClient(ce.Viper, logger)), ce.Validate, logger)
+	elasticsearchUseCase := usecase.NewElasticsearchUsecase(ce.Elastic, logger, ce.Validate, ce.Viper)
+	imageUseCase := usecase.NewImageUsecase(ce.DB, logger, ce.Validate, ce.Viper, repository.NewImageRepository(logger), repository.NewProductRepository(logger), repository.NewImageUploadRepository(logger), minioUseCase, elasticsearchUseCase, &repository.NoopFileScanner{})
+
+	grouped, err := imageUseCase.GroupDuplicateImages(context.Background())
+	if err != nil {
+		logger.Fatalf("❌ Grouping duplicate images failed: %v", err)
+	}
+	logger.Printf("✅ Assigned duplicate groups to %d images\n", grouped)
+}
+
+func (ce *CommandExecutor) handleReindexProducts(logger *logrus.Logger) {
+	index := ce.Viper.GetString("ELASTICSEARCH_INDEX")
+	if err := config.RecreateProductIndex(ce.Elastic, index); err != nil {
+		logger.Fatalf("❌ Recreating index %s failed: %v", index, err)
+	}
+
+	productRepository := repository.NewProductRepository(logger)
+	elasticsearchUseCase := usecase.NewElasticsearchUsecase(ce.Elastic, logger, ce.Validate, ce.Viper)
+
+	const batchSize = 200
+	indexed := 0
+	for offset := 0; ; offset += batchSize {
+		products, _, err := productRepository.GetAll(ce.DB.Order("id"), batchSize, offset, nil)
+		if err != nil {
+			logger.Fatalf("❌ Loading products failed: %v", err)
+		}
+
+		for i := range products {
+			if err := elasticsearchUseCase.InsertDocument(products[i].ID, &products[i]); err != nil {
+				logger.Warnf("⚠️ Failed to reindex product %s: %v", products[i].ID, err)
+				continue
+			}
+			indexed++
+		}
+
+		if len(products) < batchSize {
+			break
+		}
+	}
+	logger.Printf("✅ Reindexed %d products into %s\n", indexed, index)
+}
+
 func (ce *CommandExecutor) handleCreateDB(logger *logrus.Logger) {
 	dbName := ce.Viper.GetString("DB_NAME")
 	if dbName == "" {
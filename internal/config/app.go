@@ -4,6 +4,7 @@ import (
 	"golectro-product/internal/delivery/http"
 	"golectro-product/internal/delivery/http/middleware"
 	"golectro-product/internal/delivery/http/route"
+	"golectro-product/internal/delivery/scheduler"
 	"golectro-product/internal/repository"
 	"golectro-product/internal/usecase"
 
@@ -34,26 +35,89 @@ type BootstrapConfig struct {
 	Vault      *api.Client
 }
 
-func Bootstrap(config *BootstrapConfig) {
+// Bootstrap wires the HTTP routes and returns the product scheduler, which
+// the caller starts only when the server is going to run.
+func Bootstrap(config *BootstrapConfig) *scheduler.ProductScheduler {
 	productRepository := repository.NewProductRepository(config.Log)
 	minioRepository := repository.NewMinioRepository(config.Minio)
 	imageRepository := repository.NewImageRepository(config.Log)
+	imageUploadRepository := repository.NewImageUploadRepository(config.Log)
+	categoryRepository := repository.NewCategoryRepository(config.Log)
+	brandRepository := repository.NewBrandRepository(config.Log)
+	categorySpecRepository := repository.NewCategorySpecRepository(config.Log)
+	productSlugRepository := repository.NewProductSlugRepository(config.Log)
+	productStatusTransitionRepository := repository.NewProductStatusTransitionRepository(config.Log)
+	productRevisionRepository := repository.NewProductRevisionRepository(config.Log)
+	productPriceRepository := repository.NewProductPriceRepository(config.Log)
+	priceCampaignRepository := repository.NewPriceCampaignRepository(config.Log)
+	flashSaleRepository := repository.NewFlashSaleRepository(config.Log)
+	flashSaleQuotaRepository := repository.NewFlashSaleQuotaRepository(config.Redis)
+	priceListRepository := repository.NewPriceListRepository(config.Log)
+	priceTierRepository := repository.NewPriceTierRepository(config.Log)
+	bundleComponentRepository := repository.NewBundleComponentRepository(config.Log)
+	productRelationRepository := repository.NewProductRelationRepository(config.Log)
+	productReviewRepository := repository.NewProductReviewRepository(config.Log)
+	productReviewVoteRepository := repository.NewProductReviewVoteRepository(config.Log)
+	productQuestionRepository := repository.NewProductQuestionRepository(config.Log)
+	productAnswerRepository := repository.NewProductAnswerRepository(config.Log)
+	productQAVoteRepository := repository.NewProductQAVoteRepository(config.Log)
+	purchaseVerifier := repository.NewLocalPurchaseVerifier(config.Viper, config.Log)
+	exchangeRateRepository := repository.NewExchangeRateRepository(config.Log)
 
 	elasticsearchUseCase := usecase.NewElasticsearchUsecase(config.Elastic, config.Log, config.Validate, config.Viper)
-	productUseCase := usecase.NewProductUsecase(config.DB, config.Log, config.Validate, productRepository, imageRepository, elasticsearchUseCase)
+	productUseCase := usecase.NewProductUsecase(config.DB, config.Log, config.Validate, productRepository, imageRepository, categoryRepository, brandRepository, categorySpecRepository, productSlugRepository, productStatusTransitionRepository, productRevisionRepository, productPriceRepository, priceCampaignRepository, flashSaleRepository, flashSaleQuotaRepository, priceListRepository, priceTierRepository, bundleComponentRepository, productRelationRepository, elasticsearchUseCase)
 	minioUseCase := usecase.NewMinioUsecase(minioRepository, config.Validate, config.Log)
-	imageUseCase := usecase.NewImageUsecase(config.DB, config.Log, config.Validate, imageRepository, elasticsearchUseCase)
+	fileScanner := repository.NewFileScanner(config.Viper, config.Log)
+	imageUseCase := usecase.NewImageUsecase(config.DB, config.Log, config.Validate, config.Viper, imageRepository, productRepository, imageUploadRepository, minioUseCase, elasticsearchUseCase, fileScanner)
+	imageRenditionUseCase := usecase.NewImageRenditionUsecase(config.Log, config.Validate, config.Viper, minioUseCase)
+	categoryUseCase := usecase.NewCategoryUsecase(config.DB, config.Log, config.Validate, categoryRepository, categorySpecRepository, productRepository, elasticsearchUseCase)
+	productRevisionUseCase := usecase.NewProductRevisionUsecase(config.DB, config.Log, productRepository, productRevisionRepository, productUseCase)
+	priceCampaignUseCase := usecase.NewPriceCampaignUsecase(config.DB, config.Log, config.Validate, priceCampaignRepository, productRepository, categoryRepository, brandRepository, elasticsearchUseCase)
+	flashSaleUseCase := usecase.NewFlashSaleUsecase(config.DB, config.Log, config.Validate, flashSaleRepository, flashSaleQuotaRepository, productRepository)
+	priceListUseCase := usecase.NewPriceListUsecase(config.DB, config.Log, config.Validate, priceListRepository, priceTierRepository, productRepository)
+	bundleUseCase := usecase.NewBundleUsecase(config.DB, config.Log, config.Validate, productRepository, bundleComponentRepository, elasticsearchUseCase)
+	productRelationUseCase := usecase.NewProductRelationUsecase(config.DB, config.Log, config.Validate, productRepository, productRelationRepository)
+	currencyUseCase := usecase.NewCurrencyUsecase(config.DB, config.Log, config.Validate, exchangeRateRepository)
+	reviewUseCase := usecase.NewReviewUsecase(config.DB, config.Log, config.Validate, config.Viper, productRepository, productReviewRepository, productReviewVoteRepository, purchaseVerifier, minioUseCase, elasticsearchUseCase)
+	qaUseCase := usecase.NewQAUsecase(config.DB, config.Log, config.Validate, productRepository, productQuestionRepository, productAnswerRepository, productQAVoteRepository, purchaseVerifier)
+	brandUseCase := usecase.NewBrandUsecase(config.DB, config.Log, config.Validate, config.Viper, brandRepository, productRepository, minioUseCase, elasticsearchUseCase)
 
-	productController := http.NewProductController(productUseCase, minioUseCase, config.Log, config.Viper, imageUseCase, elasticsearchUseCase)
+	productController := http.NewProductController(productUseCase, minioUseCase, config.Log, config.Viper, imageUseCase, elasticsearchUseCase, categoryUseCase, currencyUseCase, imageRenditionUseCase)
+	categoryController := http.NewCategoryController(categoryUseCase, config.Log)
+	brandController := http.NewBrandController(brandUseCase, config.Log)
+	productRevisionController := http.NewProductRevisionController(productRevisionUseCase, config.Log)
+	priceCampaignController := http.NewPriceCampaignController(priceCampaignUseCase, config.Log)
+	flashSaleController := http.NewFlashSaleController(flashSaleUseCase, config.Log)
+	currencyController := http.NewCurrencyController(currencyUseCase, config.Log)
+	priceListController := http.NewPriceListController(priceListUseCase, productUseCase, config.Log)
+	bundleController := http.NewBundleController(bundleUseCase, config.Log)
+	productRelationController := http.NewProductRelationController(productRelationUseCase, productUseCase, config.Log)
+	reviewController := http.NewReviewController(reviewUseCase, config.Log)
+	qaController := http.NewQAController(qaUseCase, config.Log)
 
 	authMiddleware := middleware.NewAuth(config.Viper)
 
 	routeConfig := route.RouteConfig{
-		App:               config.App,
-		AuthMiddleware:    authMiddleware,
-		Minio:             config.Minio,
-		Viper:             config.Viper,
-		ProductController: productController,
+		App:                       config.App,
+		AuthMiddleware:            authMiddleware,
+		FileScanner:               fileScanner,
+		Minio:                     config.Minio,
+		Viper:                     config.Viper,
+		Log:                       config.Log,
+		ProductController:         productController,
+		CategoryController:        categoryController,
+		BrandController:           brandController,
+		ProductRevisionController: productRevisionController,
+		PriceCampaignController:   priceCampaignController,
+		FlashSaleController:       flashSaleController,
+		CurrencyController:        currencyController,
+		PriceListController:       priceListController,
+		BundleController:          bundleController,
+		ProductRelationController: productRelationController,
+		ReviewController:          reviewController,
+		QAController:              qaController,
 	}
 	routeConfig.Setup()
+
+	return scheduler.NewProductScheduler(productUseCase, priceCampaignUseCase, config.Redis, config.Log, config.Viper)
 }
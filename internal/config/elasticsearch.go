@@ -2,6 +2,7 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/elastic/go-elasticsearch/v8"
@@ -9,6 +10,35 @@ import (
 	"github.com/spf13/viper"
 )
 
+const productIndexProperties = `{
+	"properties": {
+		"price_dropped_at": {"type": "date"},
+		"price": {"type": "long"},
+		"compare_at_price": {"type": "long"},
+		"effective_price": {"type": "long"},
+		"currency": {"type": "keyword"},
+		"type": {"type": "keyword"},
+		"rating_average": {"type": "double"},
+		"rating_count": {"type": "integer"},
+		"translations": {
+			"properties": {
+				"en": {
+					"properties": {
+						"name": {"type": "text", "analyzer": "english"},
+						"description": {"type": "text", "analyzer": "english"}
+					}
+				},
+				"id": {
+					"properties": {
+						"name": {"type": "text", "analyzer": "indonesian"},
+						"description": {"type": "text", "analyzer": "indonesian"}
+					}
+				}
+			}
+		}
+	}
+}`
+
 func NewElasticSearch(viper *viper.Viper, log *logrus.Logger) *elasticsearch.Client {
 	indexesStr := viper.GetString("ELASTICSEARCH_INDEXES")
 	if indexesStr == "" {
@@ -53,23 +83,25 @@ func NewElasticSearch(viper *viper.Viper, log *logrus.Logger) *elasticsearch.Cli
 
 		switch existsRes.StatusCode {
 		case 404:
-			createRes, err := es.Indices.Create(index)
-			if err != nil {
+			if err := createProductIndex(es, index); err != nil {
 				log.Fatalf("Failed to create index '%s': %v", index, err)
 			}
-			defer createRes.Body.Close()
-
-			if createRes.IsError() {
-				var e map[string]any
-				_ = json.NewDecoder(createRes.Body).Decode(&e)
-				log.Fatalf("Elasticsearch index creation error for '%s': %v", index, e)
-			}
 
 			log.Infof("Created new Elasticsearch index: %s", index)
 
 		case 200:
 			log.Infof("Elasticsearch index already exists: %s", index)
 
+			mappingRes, err := es.Indices.PutMapping([]string{index}, strings.NewReader(productIndexProperties))
+			if err != nil {
+				log.Fatalf("Failed to update mapping for index '%s': %v", index, err)
+			}
+			defer mappingRes.Body.Close()
+
+			if mappingRes.IsError() {
+				log.Warnf("Elasticsearch mapping update error for '%s': %s", index, mappingRes.String())
+			}
+
 		default:
 			log.Fatalf("Unexpected response checking index '%s': %s", index, existsRes.String())
 		}
@@ -77,3 +109,35 @@ func NewElasticSearch(viper *viper.Viper, log *logrus.Logger) *elasticsearch.Cli
 
 	return es
 }
+
+// RecreateProductIndex drops the index and creates it again with the current
+// mapping, for changes that cannot be applied to an existing index.
+func RecreateProductIndex(es *elasticsearch.Client, index string) error {
+	deleteRes, err := es.Indices.Delete([]string{index}, es.Indices.Delete.WithIgnoreUnavailable(true))
+	if err != nil {
+		return err
+	}
+	defer deleteRes.Body.Close()
+
+	if deleteRes.IsError() {
+		return fmt.Errorf("delete index '%s': %s", index, deleteRes.String())
+	}
+
+	return createProductIndex(es, index)
+}
+
+func createProductIndex(es *elasticsearch.Client, index string) error {
+	createRes, err := es.Indices.Create(index, es.Indices.Create.WithBody(strings.NewReader(`{"mappings": `+productIndexProperties+`}`)))
+	if err != nil {
+		return err
+	}
+	defer createRes.Body.Close()
+
+	if createRes.IsError() {
+		var e map[string]any
+		_ = json.NewDecoder(createRes.Body).Decode(&e)
+		return fmt.Errorf("elasticsearch index creation error for '%s': %v", index, e)
+	}
+
+	return nil
+}
@@ -7,17 +7,32 @@ import (
 
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/go-playground/validator/v10"
+	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"gorm.io/gorm"
 )
 
-func StartGRPC(viper *viper.Viper, db *gorm.DB, validate *validator.Validate, log *logrus.Logger, elastic *elasticsearch.Client) {
+func StartGRPC(viper *viper.Viper, db *gorm.DB, validate *validator.Validate, log *logrus.Logger, elastic *elasticsearch.Client, redis *redis.Client) {
 	productRepository := repository.NewProductRepository(log)
 	imageRepository := repository.NewImageRepository(log)
+	categoryRepository := repository.NewCategoryRepository(log)
+	brandRepository := repository.NewBrandRepository(log)
+	categorySpecRepository := repository.NewCategorySpecRepository(log)
+	productSlugRepository := repository.NewProductSlugRepository(log)
+	productStatusTransitionRepository := repository.NewProductStatusTransitionRepository(log)
+	productRevisionRepository := repository.NewProductRevisionRepository(log)
+	productPriceRepository := repository.NewProductPriceRepository(log)
+	priceCampaignRepository := repository.NewPriceCampaignRepository(log)
+	flashSaleRepository := repository.NewFlashSaleRepository(log)
+	flashSaleQuotaRepository := repository.NewFlashSaleQuotaRepository(redis)
+	priceListRepository := repository.NewPriceListRepository(log)
+	priceTierRepository := repository.NewPriceTierRepository(log)
+	bundleComponentRepository := repository.NewBundleComponentRepository(log)
+	productRelationRepository := repository.NewProductRelationRepository(log)
 
 	elasticsearchUseCase := usecase.NewElasticsearchUsecase(elastic, log, validate, viper)
-	productUseCase := usecase.NewProductUsecase(db, log, validate, productRepository, imageRepository, elasticsearchUseCase)
+	productUseCase := usecase.NewProductUsecase(db, log, validate, productRepository, imageRepository, categoryRepository, brandRepository, categorySpecRepository, productSlugRepository, productStatusTransitionRepository, productRevisionRepository, productPriceRepository, priceCampaignRepository, flashSaleRepository, flashSaleQuotaRepository, priceListRepository, priceTierRepository, bundleComponentRepository, productRelationRepository, elasticsearchUseCase)
 
 	port := viper.GetInt("GRPC_PORT")
 	grpc.StartGRPCServer(productUseCase, port, viper)
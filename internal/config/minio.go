@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/minio/minio-go/v7"
@@ -35,7 +36,17 @@ func NewMinioClient(viper *viper.Viper, logger *logrus.Logger) *minio.Client {
 		logger.Fatal("MINIO_BUCKETS is required in environment variables")
 	}
 
+	staging := viper.GetString("MINIO_BUCKET_STAGING")
+	if staging == "" {
+		logger.Fatal("MINIO_BUCKET_STAGING is required in environment variables")
+	}
+
 	buckets := strings.Split(bucketsStr, ",")
+	for _, bucket := range []string{staging, viper.GetString("MINIO_BUCKET_QUARANTINE")} {
+		if bucket != "" && !slices.Contains(buckets, bucket) {
+			buckets = append(buckets, bucket)
+		}
+	}
 	for _, bucket := range buckets {
 		bucket = strings.TrimSpace(bucket)
 		if bucket == "" {
@@ -0,0 +1,77 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetBrands = model.Message{
+		"en": "Successfully retrieved brands",
+		"id": "Berhasil mendapatkan merek",
+	}
+	SuccessGetBrandByID = model.Message{
+		"en": "Successfully retrieved brand by ID",
+		"id": "Berhasil mendapatkan merek berdasarkan ID",
+	}
+	SuccessCreateBrand = model.Message{
+		"en": "Successfully created brand",
+		"id": "Berhasil membuat merek",
+	}
+	SuccessUpdateBrand = model.Message{
+		"en": "Successfully updated brand",
+		"id": "Berhasil memperbarui merek",
+	}
+	SuccessDeleteBrand = model.Message{
+		"en": "Successfully deleted brand",
+		"id": "Berhasil menghapus merek",
+	}
+	SuccessUploadBrandLogo = model.Message{
+		"en": "Successfully uploaded brand logo",
+		"id": "Berhasil mengunggah logo merek",
+	}
+)
+
+var (
+	FailedGetBrands = model.Message{
+		"en": "Failed to get brands",
+		"id": "Gagal mendapatkan merek",
+	}
+	FailedGetBrandByID = model.Message{
+		"en": "Failed to get brand by ID",
+		"id": "Gagal mendapatkan merek berdasarkan ID",
+	}
+	FailedCreateBrand = model.Message{
+		"en": "Failed to create brand",
+		"id": "Gagal membuat merek",
+	}
+	FailedUpdateBrand = model.Message{
+		"en": "Failed to update brand",
+		"id": "Gagal memperbarui merek",
+	}
+	FailedDeleteBrand = model.Message{
+		"en": "Failed to delete brand",
+		"id": "Gagal menghapus merek",
+	}
+	FailedUploadBrandLogo = model.Message{
+		"en": "Failed to upload brand logo",
+		"id": "Gagal mengunggah logo merek",
+	}
+	BrandNotFound = model.Message{
+		"en": "Brand not found",
+		"id": "Merek tidak ditemukan",
+	}
+	InvalidBrandID = model.Message{
+		"en": "Invalid brand ID",
+		"id": "ID merek tidak valid",
+	}
+	InvalidBrandIDFormat = model.Message{
+		"en": "Invalid brand ID format",
+		"id": "Format ID merek tidak valid",
+	}
+	BrandSlugAlreadyExists = model.Message{
+		"en": "Brand slug already exists",
+		"id": "Slug merek sudah digunakan",
+	}
+	BrandHasProducts = model.Message{
+		"en": "Brand is still used by products",
+		"id": "Merek masih digunakan oleh produk",
+	}
+)
@@ -0,0 +1,45 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessUpdateBundleComponents = model.Message{
+		"en": "Successfully updated bundle components",
+		"id": "Berhasil memperbarui komponen bundel",
+	}
+)
+
+var (
+	FailedUpdateBundleComponents = model.Message{
+		"en": "Failed to update bundle components",
+		"id": "Gagal memperbarui komponen bundel",
+	}
+	FailedSyncBundleStock = model.Message{
+		"en": "Failed to sync bundle stock",
+		"id": "Gagal menyinkronkan stok bundel",
+	}
+	ProductNotBundle = model.Message{
+		"en": "Product is not a bundle",
+		"id": "Produk bukan bundel",
+	}
+	BundleComponentsNotAllowed = model.Message{
+		"en": "Components can only be set on bundle products",
+		"id": "Komponen hanya dapat diatur pada produk bundel",
+	}
+	BundleComponentNotFound = model.Message{
+		"en": "Bundle component product not found",
+		"id": "Produk komponen bundel tidak ditemukan",
+	}
+	BundleComponentInvalid = model.Message{
+		"en": "Bundle components must be simple products other than the bundle itself",
+		"id": "Komponen bundel harus berupa produk biasa selain bundel itu sendiri",
+	}
+	BundleQuantityDerived = model.Message{
+		"en": "Bundle stock is derived from its components and cannot be set directly",
+		"id": "Stok bundel dihitung dari komponennya dan tidak dapat diatur langsung",
+	}
+	ProductUsedInBundle = model.Message{
+		"en": "Product is a component of an active bundle",
+		"id": "Produk merupakan komponen dari bundel yang aktif",
+	}
+)
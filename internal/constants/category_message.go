@@ -0,0 +1,81 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetCategories = model.Message{
+		"en": "Successfully retrieved categories",
+		"id": "Berhasil mendapatkan kategori",
+	}
+	SuccessGetCategoryByID = model.Message{
+		"en": "Successfully retrieved category by ID",
+		"id": "Berhasil mendapatkan kategori berdasarkan ID",
+	}
+	SuccessCreateCategory = model.Message{
+		"en": "Successfully created category",
+		"id": "Berhasil membuat kategori",
+	}
+	SuccessUpdateCategory = model.Message{
+		"en": "Successfully updated category",
+		"id": "Berhasil memperbarui kategori",
+	}
+	SuccessDeleteCategory = model.Message{
+		"en": "Successfully deleted category",
+		"id": "Berhasil menghapus kategori",
+	}
+)
+
+var (
+	FailedGetCategories = model.Message{
+		"en": "Failed to get categories",
+		"id": "Gagal mendapatkan kategori",
+	}
+	FailedGetCategoryByID = model.Message{
+		"en": "Failed to get category by ID",
+		"id": "Gagal mendapatkan kategori berdasarkan ID",
+	}
+	FailedCreateCategory = model.Message{
+		"en": "Failed to create category",
+		"id": "Gagal membuat kategori",
+	}
+	FailedUpdateCategory = model.Message{
+		"en": "Failed to update category",
+		"id": "Gagal memperbarui kategori",
+	}
+	FailedDeleteCategory = model.Message{
+		"en": "Failed to delete category",
+		"id": "Gagal menghapus kategori",
+	}
+	CategoryNotFound = model.Message{
+		"en": "Category not found",
+		"id": "Kategori tidak ditemukan",
+	}
+	ParentCategoryNotFound = model.Message{
+		"en": "Parent category not found",
+		"id": "Kategori induk tidak ditemukan",
+	}
+	InvalidCategoryID = model.Message{
+		"en": "Invalid category ID",
+		"id": "ID kategori tidak valid",
+	}
+	InvalidCategoryIDFormat = model.Message{
+		"en": "Invalid category ID format",
+		"id": "Format ID kategori tidak valid",
+	}
+	InvalidCategorySlug = model.Message{
+		"en": "Category slug is required when no English name is given",
+		"id": "Slug kategori wajib diisi jika nama bahasa Inggris tidak diberikan",
+	}
+	CategorySlugAlreadyExists = model.Message{
+		"en": "Category slug already exists",
+		"id": "Slug kategori sudah digunakan",
+	}
+	InvalidCategoryParent = model.Message{
+		"en": "Category cannot be moved under itself or its descendants",
+		"id": "Kategori tidak dapat dipindahkan ke bawah dirinya sendiri atau turunannya",
+	}
+	CategoryHasChildren = model.Message{
+		"en": "Category still has subcategories",
+		"id": "Kategori masih memiliki subkategori",
+	}
+)
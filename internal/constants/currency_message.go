@@ -0,0 +1,33 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetExchangeRates = model.Message{
+		"en": "Successfully retrieved exchange rates",
+		"id": "Berhasil mendapatkan kurs mata uang",
+	}
+	SuccessUpdateExchangeRates = model.Message{
+		"en": "Successfully updated exchange rates",
+		"id": "Berhasil memperbarui kurs mata uang",
+	}
+)
+
+var (
+	FailedGetExchangeRates = model.Message{
+		"en": "Failed to get exchange rates",
+		"id": "Gagal mendapatkan kurs mata uang",
+	}
+	FailedUpdateExchangeRates = model.Message{
+		"en": "Failed to update exchange rates",
+		"id": "Gagal memperbarui kurs mata uang",
+	}
+	FailedConvertCurrency = model.Message{
+		"en": "Failed to convert prices to the requested currency",
+		"id": "Gagal mengonversi harga ke mata uang yang diminta",
+	}
+	ExchangeRateUnavailable = model.Message{
+		"en": "Exchange rate for the requested currency is not available",
+		"id": "Kurs untuk mata uang yang diminta tidak tersedia",
+	}
+)
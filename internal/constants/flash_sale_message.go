@@ -0,0 +1,69 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetFlashSales = model.Message{
+		"en": "Successfully retrieved flash sales",
+		"id": "Berhasil mendapatkan flash sale",
+	}
+	SuccessGetFlashSaleByID = model.Message{
+		"en": "Successfully retrieved flash sale by ID",
+		"id": "Berhasil mendapatkan flash sale berdasarkan ID",
+	}
+	SuccessCreateFlashSale = model.Message{
+		"en": "Successfully created flash sale",
+		"id": "Berhasil membuat flash sale",
+	}
+	SuccessUpdateFlashSale = model.Message{
+		"en": "Successfully updated flash sale",
+		"id": "Berhasil memperbarui flash sale",
+	}
+	SuccessDeleteFlashSale = model.Message{
+		"en": "Successfully deleted flash sale",
+		"id": "Berhasil menghapus flash sale",
+	}
+)
+
+var (
+	FailedGetFlashSales = model.Message{
+		"en": "Failed to get flash sales",
+		"id": "Gagal mendapatkan flash sale",
+	}
+	FailedGetFlashSaleByID = model.Message{
+		"en": "Failed to get flash sale by ID",
+		"id": "Gagal mendapatkan flash sale berdasarkan ID",
+	}
+	FailedCreateFlashSale = model.Message{
+		"en": "Failed to create flash sale",
+		"id": "Gagal membuat flash sale",
+	}
+	FailedUpdateFlashSale = model.Message{
+		"en": "Failed to update flash sale",
+		"id": "Gagal memperbarui flash sale",
+	}
+	FailedDeleteFlashSale = model.Message{
+		"en": "Failed to delete flash sale",
+		"id": "Gagal menghapus flash sale",
+	}
+	FlashSaleNotFound = model.Message{
+		"en": "Flash sale not found",
+		"id": "Flash sale tidak ditemukan",
+	}
+	FlashSaleOverlaps = model.Message{
+		"en": "Product already has a flash sale in this period",
+		"id": "Produk sudah memiliki flash sale pada periode ini",
+	}
+	FlashSaleQuotaBelowSold = model.Message{
+		"en": "Flash sale quota cannot be lower than units already sold",
+		"id": "Kuota flash sale tidak boleh lebih kecil dari unit yang sudah terjual",
+	}
+	InvalidFlashSaleID = model.Message{
+		"en": "Invalid flash sale ID",
+		"id": "ID flash sale tidak valid",
+	}
+	InvalidFlashSaleIDFormat = model.Message{
+		"en": "Invalid flash sale ID format",
+		"id": "Format ID flash sale tidak valid",
+	}
+)
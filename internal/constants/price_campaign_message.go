@@ -0,0 +1,77 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetPriceCampaigns = model.Message{
+		"en": "Successfully retrieved price campaigns",
+		"id": "Berhasil mendapatkan kampanye harga",
+	}
+	SuccessGetPriceCampaignByID = model.Message{
+		"en": "Successfully retrieved price campaign by ID",
+		"id": "Berhasil mendapatkan kampanye harga berdasarkan ID",
+	}
+	SuccessCreatePriceCampaign = model.Message{
+		"en": "Successfully created price campaign",
+		"id": "Berhasil membuat kampanye harga",
+	}
+	SuccessUpdatePriceCampaign = model.Message{
+		"en": "Successfully updated price campaign",
+		"id": "Berhasil memperbarui kampanye harga",
+	}
+	SuccessDeletePriceCampaign = model.Message{
+		"en": "Successfully deleted price campaign",
+		"id": "Berhasil menghapus kampanye harga",
+	}
+)
+
+var (
+	FailedGetPriceCampaigns = model.Message{
+		"en": "Failed to get price campaigns",
+		"id": "Gagal mendapatkan kampanye harga",
+	}
+	FailedGetPriceCampaignByID = model.Message{
+		"en": "Failed to get price campaign by ID",
+		"id": "Gagal mendapatkan kampanye harga berdasarkan ID",
+	}
+	FailedCreatePriceCampaign = model.Message{
+		"en": "Failed to create price campaign",
+		"id": "Gagal membuat kampanye harga",
+	}
+	FailedUpdatePriceCampaign = model.Message{
+		"en": "Failed to update price campaign",
+		"id": "Gagal memperbarui kampanye harga",
+	}
+	FailedDeletePriceCampaign = model.Message{
+		"en": "Failed to delete price campaign",
+		"id": "Gagal menghapus kampanye harga",
+	}
+	FailedResolveEffectivePrice = model.Message{
+		"en": "Failed to resolve effective price",
+		"id": "Gagal menentukan harga efektif",
+	}
+	PriceCampaignNotFound = model.Message{
+		"en": "Price campaign not found",
+		"id": "Kampanye harga tidak ditemukan",
+	}
+	PriceCampaignTargetNotFound = model.Message{
+		"en": "Price campaign target not found",
+		"id": "Target kampanye harga tidak ditemukan",
+	}
+	InvalidPriceCampaignID = model.Message{
+		"en": "Invalid price campaign ID",
+		"id": "ID kampanye harga tidak valid",
+	}
+	InvalidPriceCampaignIDFormat = model.Message{
+		"en": "Invalid price campaign ID format",
+		"id": "Format ID kampanye harga tidak valid",
+	}
+	InvalidPercentageDiscount = model.Message{
+		"en": "Percentage discount cannot exceed 10000 basis points",
+		"id": "Diskon persentase tidak boleh melebihi 10000 basis poin",
+	}
+	InvalidCompareAtPrice = model.Message{
+		"en": "Compare-at price must be greater than the price",
+		"id": "Harga coret harus lebih besar dari harga",
+	}
+)
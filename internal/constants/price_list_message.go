@@ -0,0 +1,93 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetPriceLists = model.Message{
+		"en": "Successfully retrieved price lists",
+		"id": "Berhasil mendapatkan daftar harga",
+	}
+	SuccessGetPriceListByID = model.Message{
+		"en": "Successfully retrieved price list by ID",
+		"id": "Berhasil mendapatkan daftar harga berdasarkan ID",
+	}
+	SuccessCreatePriceList = model.Message{
+		"en": "Successfully created price list",
+		"id": "Berhasil membuat daftar harga",
+	}
+	SuccessUpdatePriceList = model.Message{
+		"en": "Successfully updated price list",
+		"id": "Berhasil memperbarui daftar harga",
+	}
+	SuccessDeletePriceList = model.Message{
+		"en": "Successfully deleted price list",
+		"id": "Berhasil menghapus daftar harga",
+	}
+	SuccessGetPriceTiers = model.Message{
+		"en": "Successfully retrieved price tiers",
+		"id": "Berhasil mendapatkan tingkatan harga",
+	}
+	SuccessUpdatePriceTiers = model.Message{
+		"en": "Successfully updated price tiers",
+		"id": "Berhasil memperbarui tingkatan harga",
+	}
+	SuccessQuotePrice = model.Message{
+		"en": "Successfully quoted price",
+		"id": "Berhasil menghitung penawaran harga",
+	}
+)
+
+var (
+	FailedGetPriceLists = model.Message{
+		"en": "Failed to get price lists",
+		"id": "Gagal mendapatkan daftar harga",
+	}
+	FailedGetPriceListByID = model.Message{
+		"en": "Failed to get price list by ID",
+		"id": "Gagal mendapatkan daftar harga berdasarkan ID",
+	}
+	FailedCreatePriceList = model.Message{
+		"en": "Failed to create price list",
+		"id": "Gagal membuat daftar harga",
+	}
+	FailedUpdatePriceList = model.Message{
+		"en": "Failed to update price list",
+		"id": "Gagal memperbarui daftar harga",
+	}
+	FailedDeletePriceList = model.Message{
+		"en": "Failed to delete price list",
+		"id": "Gagal menghapus daftar harga",
+	}
+	FailedGetPriceTiers = model.Message{
+		"en": "Failed to get price tiers",
+		"id": "Gagal mendapatkan tingkatan harga",
+	}
+	FailedUpdatePriceTiers = model.Message{
+		"en": "Failed to update price tiers",
+		"id": "Gagal memperbarui tingkatan harga",
+	}
+	FailedQuotePrice = model.Message{
+		"en": "Failed to quote price",
+		"id": "Gagal menghitung penawaran harga",
+	}
+	PriceListNotFound = model.Message{
+		"en": "Price list not found",
+		"id": "Daftar harga tidak ditemukan",
+	}
+	PriceListCustomerGroupTaken = model.Message{
+		"en": "A price list already exists for this customer group",
+		"id": "Daftar harga untuk grup pelanggan ini sudah ada",
+	}
+	InvalidPriceListID = model.Message{
+		"en": "Invalid price list ID",
+		"id": "ID daftar harga tidak valid",
+	}
+	InvalidPriceListIDFormat = model.Message{
+		"en": "Invalid price list ID format",
+		"id": "Format ID daftar harga tidak valid",
+	}
+	InvalidQuoteQuantity = model.Message{
+		"en": "Quantity must be a positive number",
+		"id": "Jumlah harus berupa angka positif",
+	}
+)
@@ -19,6 +19,50 @@ var (
 		"en": "Successfully deleted product image",
 		"id": "Berhasil menghapus gambar produk",
 	}
+	SuccessUpdateProductImage = model.Message{
+		"en": "Successfully updated product image",
+		"id": "Berhasil memperbarui gambar produk",
+	}
+	SuccessCreateImageUploadURLs = model.Message{
+		"en": "Successfully created image upload URLs",
+		"id": "Berhasil membuat URL unggah gambar",
+	}
+	SuccessConfirmImageUpload = model.Message{
+		"en": "Successfully confirmed image upload",
+		"id": "Berhasil mengonfirmasi unggahan gambar",
+	}
+	SuccessGetDuplicateImages = model.Message{
+		"en": "Successfully retrieved duplicate images",
+		"id": "Berhasil mendapatkan gambar duplikat",
+	}
+	SuccessReorderProductImages = model.Message{
+		"en": "Successfully reordered product images",
+		"id": "Berhasil mengurutkan ulang gambar produk",
+	}
+	SuccessGetProductBySlug = model.Message{
+		"en": "Successfully retrieved product by slug",
+		"id": "Berhasil mendapatkan produk berdasarkan slug",
+	}
+	SuccessUpdateProductStatus = model.Message{
+		"en": "Successfully updated product status",
+		"id": "Berhasil memperbarui status produk",
+	}
+	SuccessGetProductStatusTransitions = model.Message{
+		"en": "Successfully retrieved product status history",
+		"id": "Berhasil mendapatkan riwayat status produk",
+	}
+	SuccessGetProductPriceHistory = model.Message{
+		"en": "Successfully retrieved product price history",
+		"id": "Berhasil mendapatkan riwayat harga produk",
+	}
+	SuccessUpdateProductSchedule = model.Message{
+		"en": "Successfully updated product schedule",
+		"id": "Berhasil memperbarui jadwal produk",
+	}
+	SuccessRestoreProduct = model.Message{
+		"en": "Successfully restored product",
+		"id": "Berhasil memulihkan produk",
+	}
 	SuccessSearchProducts = model.Message{
 		"en": "Successfully searched products",
 		"id": "Berhasil mencari produk",
@@ -54,6 +98,54 @@ var (
 		"en": "Failed to get product by ID",
 		"id": "Gagal mendapatkan produk berdasarkan ID",
 	}
+	FailedGetProductBySlug = model.Message{
+		"en": "Failed to get product by slug",
+		"id": "Gagal mendapatkan produk berdasarkan slug",
+	}
+	InvalidProductSlug = model.Message{
+		"en": "Invalid product slug",
+		"id": "Slug produk tidak valid",
+	}
+	ProductSlugAlreadyExists = model.Message{
+		"en": "Product slug already exists",
+		"id": "Slug produk sudah digunakan",
+	}
+	FailedUpdateProductStatus = model.Message{
+		"en": "Failed to update product status",
+		"id": "Gagal memperbarui status produk",
+	}
+	FailedGetProductStatusTransitions = model.Message{
+		"en": "Failed to get product status history",
+		"id": "Gagal mendapatkan riwayat status produk",
+	}
+	FailedGetProductPriceHistory = model.Message{
+		"en": "Failed to get product price history",
+		"id": "Gagal mendapatkan riwayat harga produk",
+	}
+	InvalidProductStatus = model.Message{
+		"en": "Invalid product status",
+		"id": "Status produk tidak valid",
+	}
+	InvalidProductStatusTransition = model.Message{
+		"en": "Product status transition is not allowed",
+		"id": "Perubahan status produk tidak diizinkan",
+	}
+	FailedUpdateProductSchedule = model.Message{
+		"en": "Failed to update product schedule",
+		"id": "Gagal memperbarui jadwal produk",
+	}
+	InvalidProductSchedule = model.Message{
+		"en": "Unpublish time must be after publish time",
+		"id": "Waktu akhir tayang harus setelah waktu tayang",
+	}
+	FailedRestoreProduct = model.Message{
+		"en": "Failed to restore product",
+		"id": "Gagal memulihkan produk",
+	}
+	ProductNotDeleted = model.Message{
+		"en": "Product is not deleted",
+		"id": "Produk tidak dalam keadaan terhapus",
+	}
 	FailedGetProductsByIDs = model.Message{
 		"en": "Failed to get products by IDs",
 		"id": "Gagal mendapatkan produk berdasarkan ID",
@@ -78,6 +170,10 @@ var (
 		"en": "Insufficient product quantity",
 		"id": "Jumlah produk tidak mencukupi",
 	}
+	InvalidDecreaseQuantity = model.Message{
+		"en": "Quantity to decrease must be a positive number",
+		"id": "Jumlah yang dikurangi harus berupa angka positif",
+	}
 	InvalidProductIDFormat = model.Message{
 		"en": "Invalid product ID format",
 		"id": "Format ID produk tidak valid",
@@ -126,4 +222,28 @@ var (
 		"en": "Failed to delete image from Minio",
 		"id": "Gagal menghapus gambar dari Minio",
 	}
+	InvalidImageID = model.Message{
+		"en": "Invalid image ID",
+		"id": "ID gambar tidak valid",
+	}
+	InvalidImageIDFormat = model.Message{
+		"en": "Invalid image ID format",
+		"id": "Format ID gambar tidak valid",
+	}
+	FailedUpdateProductImage = model.Message{
+		"en": "Failed to update product image",
+		"id": "Gagal memperbarui gambar produk",
+	}
+	FailedReorderProductImages = model.Message{
+		"en": "Failed to reorder product images",
+		"id": "Gagal mengurutkan ulang gambar produk",
+	}
+	ImageOrderMismatch = model.Message{
+		"en": "Image order must list every image of the product exactly once",
+		"id": "Urutan gambar harus mencantumkan setiap gambar produk tepat satu kali",
+	}
+	PrimaryImageNotInOrder = model.Message{
+		"en": "Primary image must belong to the product",
+		"id": "Gambar utama harus milik produk",
+	}
 )
@@ -0,0 +1,69 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetProductRelations = model.Message{
+		"en": "Successfully retrieved product relations",
+		"id": "Berhasil mendapatkan relasi produk",
+	}
+	SuccessCreateProductRelation = model.Message{
+		"en": "Successfully created product relation",
+		"id": "Berhasil membuat relasi produk",
+	}
+	SuccessDeleteProductRelation = model.Message{
+		"en": "Successfully deleted product relation",
+		"id": "Berhasil menghapus relasi produk",
+	}
+	SuccessGetRelatedProducts = model.Message{
+		"en": "Successfully retrieved related products",
+		"id": "Berhasil mendapatkan produk terkait",
+	}
+)
+
+var (
+	FailedGetProductRelations = model.Message{
+		"en": "Failed to get product relations",
+		"id": "Gagal mendapatkan relasi produk",
+	}
+	FailedCreateProductRelation = model.Message{
+		"en": "Failed to create product relation",
+		"id": "Gagal membuat relasi produk",
+	}
+	FailedDeleteProductRelation = model.Message{
+		"en": "Failed to delete product relation",
+		"id": "Gagal menghapus relasi produk",
+	}
+	FailedGetRelatedProducts = model.Message{
+		"en": "Failed to get related products",
+		"id": "Gagal mendapatkan produk terkait",
+	}
+	ProductRelationNotFound = model.Message{
+		"en": "Product relation not found",
+		"id": "Relasi produk tidak ditemukan",
+	}
+	ProductRelationExists = model.Message{
+		"en": "Product relation already exists",
+		"id": "Relasi produk sudah ada",
+	}
+	ProductRelationSelf = model.Message{
+		"en": "A product cannot be related to itself",
+		"id": "Produk tidak dapat direlasikan dengan dirinya sendiri",
+	}
+	RelatedProductNotFound = model.Message{
+		"en": "Related product not found",
+		"id": "Produk terkait tidak ditemukan",
+	}
+	InvalidProductRelationID = model.Message{
+		"en": "Invalid product relation ID",
+		"id": "ID relasi produk tidak valid",
+	}
+	InvalidProductRelationIDFormat = model.Message{
+		"en": "Invalid product relation ID format",
+		"id": "Format ID relasi produk tidak valid",
+	}
+	InvalidProductRelationType = model.Message{
+		"en": "Invalid product relation type",
+		"id": "Jenis relasi produk tidak valid",
+	}
+)
@@ -0,0 +1,141 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetQuestions = model.Message{
+		"en": "Successfully retrieved questions",
+		"id": "Berhasil mendapatkan pertanyaan",
+	}
+	SuccessGetQuestionByID = model.Message{
+		"en": "Successfully retrieved question by ID",
+		"id": "Berhasil mendapatkan pertanyaan berdasarkan ID",
+	}
+	SuccessCreateQuestion = model.Message{
+		"en": "Successfully created question",
+		"id": "Berhasil membuat pertanyaan",
+	}
+	SuccessDeleteQuestion = model.Message{
+		"en": "Successfully deleted question",
+		"id": "Berhasil menghapus pertanyaan",
+	}
+	SuccessModerateQuestion = model.Message{
+		"en": "Successfully moderated question",
+		"id": "Berhasil memoderasi pertanyaan",
+	}
+	SuccessGetAnswers = model.Message{
+		"en": "Successfully retrieved answers",
+		"id": "Berhasil mendapatkan jawaban",
+	}
+	SuccessCreateAnswer = model.Message{
+		"en": "Successfully created answer",
+		"id": "Berhasil membuat jawaban",
+	}
+	SuccessDeleteAnswer = model.Message{
+		"en": "Successfully deleted answer",
+		"id": "Berhasil menghapus jawaban",
+	}
+	SuccessModerateAnswer = model.Message{
+		"en": "Successfully moderated answer",
+		"id": "Berhasil memoderasi jawaban",
+	}
+	SuccessUpvote = model.Message{
+		"en": "Successfully upvoted",
+		"id": "Berhasil memberikan dukungan",
+	}
+	SuccessRemoveUpvote = model.Message{
+		"en": "Successfully removed upvote",
+		"id": "Berhasil menghapus dukungan",
+	}
+)
+
+var (
+	FailedGetQuestions = model.Message{
+		"en": "Failed to get questions",
+		"id": "Gagal mendapatkan pertanyaan",
+	}
+	FailedGetQuestionByID = model.Message{
+		"en": "Failed to get question by ID",
+		"id": "Gagal mendapatkan pertanyaan berdasarkan ID",
+	}
+	FailedCreateQuestion = model.Message{
+		"en": "Failed to create question",
+		"id": "Gagal membuat pertanyaan",
+	}
+	FailedDeleteQuestion = model.Message{
+		"en": "Failed to delete question",
+		"id": "Gagal menghapus pertanyaan",
+	}
+	FailedModerateQuestion = model.Message{
+		"en": "Failed to moderate question",
+		"id": "Gagal memoderasi pertanyaan",
+	}
+	FailedGetAnswers = model.Message{
+		"en": "Failed to get answers",
+		"id": "Gagal mendapatkan jawaban",
+	}
+	FailedCreateAnswer = model.Message{
+		"en": "Failed to create answer",
+		"id": "Gagal membuat jawaban",
+	}
+	FailedDeleteAnswer = model.Message{
+		"en": "Failed to delete answer",
+		"id": "Gagal menghapus jawaban",
+	}
+	FailedModerateAnswer = model.Message{
+		"en": "Failed to moderate answer",
+		"id": "Gagal memoderasi jawaban",
+	}
+	FailedUpvote = model.Message{
+		"en": "Failed to upvote",
+		"id": "Gagal memberikan dukungan",
+	}
+	FailedRemoveUpvote = model.Message{
+		"en": "Failed to remove upvote",
+		"id": "Gagal menghapus dukungan",
+	}
+	QuestionNotFound = model.Message{
+		"en": "Question not found",
+		"id": "Pertanyaan tidak ditemukan",
+	}
+	AnswerNotFound = model.Message{
+		"en": "Answer not found",
+		"id": "Jawaban tidak ditemukan",
+	}
+	AnswerBuyerRequired = model.Message{
+		"en": "Only admins or buyers of this product can answer",
+		"id": "Hanya admin atau pembeli produk ini yang dapat menjawab",
+	}
+	UpvoteOwn = model.Message{
+		"en": "You cannot upvote your own post",
+		"id": "Anda tidak dapat mendukung kiriman Anda sendiri",
+	}
+	UpvoteExists = model.Message{
+		"en": "You have already upvoted this post",
+		"id": "Anda sudah mendukung kiriman ini",
+	}
+	UpvoteNotFound = model.Message{
+		"en": "Upvote not found",
+		"id": "Dukungan tidak ditemukan",
+	}
+	InvalidQuestionID = model.Message{
+		"en": "Invalid question ID",
+		"id": "ID pertanyaan tidak valid",
+	}
+	InvalidQuestionIDFormat = model.Message{
+		"en": "Invalid question ID format",
+		"id": "Format ID pertanyaan tidak valid",
+	}
+	InvalidAnswerID = model.Message{
+		"en": "Invalid answer ID",
+		"id": "ID jawaban tidak valid",
+	}
+	InvalidAnswerIDFormat = model.Message{
+		"en": "Invalid answer ID format",
+		"id": "Format ID jawaban tidak valid",
+	}
+	InvalidQAStatus = model.Message{
+		"en": "Invalid moderation status",
+		"id": "Status moderasi tidak valid",
+	}
+)
@@ -0,0 +1,133 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetReviews = model.Message{
+		"en": "Successfully retrieved reviews",
+		"id": "Berhasil mendapatkan ulasan",
+	}
+	SuccessGetRatingSummary = model.Message{
+		"en": "Successfully retrieved rating summary",
+		"id": "Berhasil mendapatkan ringkasan penilaian",
+	}
+	SuccessCreateReview = model.Message{
+		"en": "Successfully created review",
+		"id": "Berhasil membuat ulasan",
+	}
+	SuccessUpdateReview = model.Message{
+		"en": "Successfully updated review",
+		"id": "Berhasil memperbarui ulasan",
+	}
+	SuccessDeleteReview = model.Message{
+		"en": "Successfully deleted review",
+		"id": "Berhasil menghapus ulasan",
+	}
+	SuccessUploadReviewPhotos = model.Message{
+		"en": "Successfully uploaded review photos",
+		"id": "Berhasil mengunggah foto ulasan",
+	}
+	SuccessModerateReview = model.Message{
+		"en": "Successfully moderated review",
+		"id": "Berhasil memoderasi ulasan",
+	}
+	SuccessVoteReview = model.Message{
+		"en": "Successfully marked review as helpful",
+		"id": "Berhasil menandai ulasan sebagai membantu",
+	}
+	SuccessRemoveReviewVote = model.Message{
+		"en": "Successfully removed helpful vote",
+		"id": "Berhasil menghapus tanda membantu",
+	}
+)
+
+var (
+	FailedGetReviews = model.Message{
+		"en": "Failed to get reviews",
+		"id": "Gagal mendapatkan ulasan",
+	}
+	FailedGetRatingSummary = model.Message{
+		"en": "Failed to get rating summary",
+		"id": "Gagal mendapatkan ringkasan penilaian",
+	}
+	FailedCreateReview = model.Message{
+		"en": "Failed to create review",
+		"id": "Gagal membuat ulasan",
+	}
+	FailedUpdateReview = model.Message{
+		"en": "Failed to update review",
+		"id": "Gagal memperbarui ulasan",
+	}
+	FailedDeleteReview = model.Message{
+		"en": "Failed to delete review",
+		"id": "Gagal menghapus ulasan",
+	}
+	FailedUploadReviewPhotos = model.Message{
+		"en": "Failed to upload review photos",
+		"id": "Gagal mengunggah foto ulasan",
+	}
+	FailedModerateReview = model.Message{
+		"en": "Failed to moderate review",
+		"id": "Gagal memoderasi ulasan",
+	}
+	FailedVoteReview = model.Message{
+		"en": "Failed to mark review as helpful",
+		"id": "Gagal menandai ulasan sebagai membantu",
+	}
+	FailedRemoveReviewVote = model.Message{
+		"en": "Failed to remove helpful vote",
+		"id": "Gagal menghapus tanda membantu",
+	}
+	FailedVerifyPurchase = model.Message{
+		"en": "Failed to verify purchase",
+		"id": "Gagal memverifikasi pembelian",
+	}
+	FailedUpdateProductRating = model.Message{
+		"en": "Failed to update product rating",
+		"id": "Gagal memperbarui penilaian produk",
+	}
+	ReviewNotFound = model.Message{
+		"en": "Review not found",
+		"id": "Ulasan tidak ditemukan",
+	}
+	ReviewAlreadyExists = model.Message{
+		"en": "You have already reviewed this product",
+		"id": "Anda sudah mengulas produk ini",
+	}
+	ReviewPurchaseRequired = model.Message{
+		"en": "Only verified buyers can review this product",
+		"id": "Hanya pembeli terverifikasi yang dapat mengulas produk ini",
+	}
+	ReviewPhotoLimitExceeded = model.Message{
+		"en": "Review photo limit exceeded",
+		"id": "Batas foto ulasan terlampaui",
+	}
+	ReviewNotApproved = model.Message{
+		"en": "Review is not approved",
+		"id": "Ulasan belum disetujui",
+	}
+	ReviewVoteOwn = model.Message{
+		"en": "You cannot vote on your own review",
+		"id": "Anda tidak dapat menilai ulasan Anda sendiri",
+	}
+	ReviewVoteExists = model.Message{
+		"en": "You have already marked this review as helpful",
+		"id": "Anda sudah menandai ulasan ini sebagai membantu",
+	}
+	ReviewVoteNotFound = model.Message{
+		"en": "Helpful vote not found",
+		"id": "Tanda membantu tidak ditemukan",
+	}
+	InvalidReviewID = model.Message{
+		"en": "Invalid review ID",
+		"id": "ID ulasan tidak valid",
+	}
+	InvalidReviewIDFormat = model.Message{
+		"en": "Invalid review ID format",
+		"id": "Format ID ulasan tidak valid",
+	}
+	InvalidReviewStatus = model.Message{
+		"en": "Invalid review status",
+		"id": "Status ulasan tidak valid",
+	}
+)
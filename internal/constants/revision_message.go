@@ -0,0 +1,41 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetProductRevisions = model.Message{
+		"en": "Successfully retrieved product revisions",
+		"id": "Berhasil mendapatkan riwayat revisi produk",
+	}
+	SuccessGetProductRevisionDiff = model.Message{
+		"en": "Successfully retrieved product revision diff",
+		"id": "Berhasil mendapatkan perbedaan revisi produk",
+	}
+	SuccessRollbackProductRevision = model.Message{
+		"en": "Successfully rolled back product to revision",
+		"id": "Berhasil mengembalikan produk ke revisi",
+	}
+)
+
+var (
+	FailedGetProductRevisions = model.Message{
+		"en": "Failed to get product revisions",
+		"id": "Gagal mendapatkan riwayat revisi produk",
+	}
+	FailedGetProductRevisionDiff = model.Message{
+		"en": "Failed to get product revision diff",
+		"id": "Gagal mendapatkan perbedaan revisi produk",
+	}
+	FailedRollbackProductRevision = model.Message{
+		"en": "Failed to roll back product revision",
+		"id": "Gagal mengembalikan revisi produk",
+	}
+	ProductRevisionNotFound = model.Message{
+		"en": "Product revision not found",
+		"id": "Revisi produk tidak ditemukan",
+	}
+	InvalidProductRevision = model.Message{
+		"en": "Invalid product revision",
+		"id": "Revisi produk tidak valid",
+	}
+)
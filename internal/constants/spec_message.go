@@ -0,0 +1,65 @@
+package constants
+
+import "golectro-product/internal/model"
+
+var (
+	SuccessGetCategorySpecs = model.Message{
+		"en": "Successfully retrieved category specs",
+		"id": "Berhasil mendapatkan spesifikasi kategori",
+	}
+	SuccessUpdateCategorySpecs = model.Message{
+		"en": "Successfully updated category specs",
+		"id": "Berhasil memperbarui spesifikasi kategori",
+	}
+)
+
+var (
+	FailedGetCategorySpecs = model.Message{
+		"en": "Failed to get category specs",
+		"id": "Gagal mendapatkan spesifikasi kategori",
+	}
+	FailedUpdateCategorySpecs = model.Message{
+		"en": "Failed to update category specs",
+		"id": "Gagal memperbarui spesifikasi kategori",
+	}
+	DuplicateCategorySpecKey = model.Message{
+		"en": "Category spec keys must be unique",
+		"id": "Kunci spesifikasi kategori harus unik",
+	}
+	InvalidSpecsFormat = model.Message{
+		"en": "Specs must be a JSON object",
+		"id": "Spesifikasi harus berupa objek JSON",
+	}
+)
+
+// Spec field templates are formatted with the spec key.
+var (
+	SpecFieldRequired = model.Message{
+		"en": "%s is required",
+		"id": "%s wajib diisi",
+	}
+	SpecFieldUnknown = model.Message{
+		"en": "%s is not a valid spec for this category",
+		"id": "%s bukan spesifikasi yang valid untuk kategori ini",
+	}
+	SpecFieldString = model.Message{
+		"en": "%s must be a text",
+		"id": "%s harus berupa teks",
+	}
+	SpecFieldNumber = model.Message{
+		"en": "%s must be a number",
+		"id": "%s harus berupa angka",
+	}
+	SpecFieldBoolean = model.Message{
+		"en": "%s must be true or false",
+		"id": "%s harus bernilai benar atau salah",
+	}
+	SpecFieldList = model.Message{
+		"en": "%s must be a list",
+		"id": "%s harus berupa daftar",
+	}
+	SpecFieldOneOf = model.Message{
+		"en": "%s must be one of [%s]",
+		"id": "%s harus berupa salah satu dari [%s]",
+	}
+)
@@ -31,4 +31,88 @@ var (
 		"en": "Failed to upload object",
 		"id": "Gagal mengunggah objek",
 	}
+	InvalidImagePreviewRequest = model.Message{
+		"en": "Invalid image preview request",
+		"id": "Permintaan pratinjau gambar tidak valid",
+	}
+	FailedGenerateRendition = model.Message{
+		"en": "Failed to generate image rendition",
+		"id": "Gagal membuat turunan gambar",
+	}
+	FailedDecodeImage = model.Message{
+		"en": "Failed to decode image",
+		"id": "Gagal membaca gambar",
+	}
+	FailedCreateImageUpload = model.Message{
+		"en": "Failed to create image upload",
+		"id": "Gagal membuat unggahan gambar",
+	}
+	FailedConfirmImageUpload = model.Message{
+		"en": "Failed to confirm image upload",
+		"id": "Gagal mengonfirmasi unggahan gambar",
+	}
+	ImageUploadNotFound = model.Message{
+		"en": "Image upload not found",
+		"id": "Unggahan gambar tidak ditemukan",
+	}
+	ImageUploadNotPending = model.Message{
+		"en": "Image upload has already been processed",
+		"id": "Unggahan gambar sudah diproses",
+	}
+	ImageUploadExpired = model.Message{
+		"en": "Image upload has expired",
+		"id": "Unggahan gambar sudah kedaluwarsa",
+	}
+	ImageUploadVerificationFailed = model.Message{
+		"en": "Uploaded image failed verification",
+		"id": "Gambar yang diunggah gagal diverifikasi",
+	}
+	ImageStorageUnavailable = model.Message{
+		"en": "Image storage is temporarily unavailable, please try again",
+		"id": "Penyimpanan gambar sedang tidak tersedia, silakan coba lagi",
+	}
+	TooManyFiles = model.Message{
+		"en": "Too many files",
+		"id": "Terlalu banyak file",
+	}
+	FileInfected = model.Message{
+		"en": "File was flagged by the malware scanner",
+		"id": "File terdeteksi oleh pemindai malware",
+	}
+	FailedQuarantineFile = model.Message{
+		"en": "Failed to quarantine file, please try again later",
+		"id": "Gagal mengarantina file, silakan coba lagi nanti",
+	}
+	FailedScanFile = model.Message{
+		"en": "Failed to scan file, please try again later",
+		"id": "Gagal memindai file, silakan coba lagi nanti",
+	}
+	InvalidImageContent = model.Message{
+		"en": "Image file is malformed or unsupported",
+		"id": "File gambar rusak atau tidak didukung",
+	}
+	ImageTooLarge = model.Message{
+		"en": "Image has too many pixels",
+		"id": "Gambar memiliki terlalu banyak piksel",
+	}
+	ImageTooManyFrames = model.Message{
+		"en": "Animated image has too many frames",
+		"id": "Gambar animasi memiliki terlalu banyak frame",
+	}
+	InvalidImageDimensions = model.Message{
+		"en": "Image dimensions are out of the allowed range",
+		"id": "Dimensi gambar di luar rentang yang diizinkan",
+	}
+	InvalidImageAspectRatio = model.Message{
+		"en": "Image aspect ratio is out of the allowed range",
+		"id": "Rasio aspek gambar di luar rentang yang diizinkan",
+	}
+	DuplicateProductImage = model.Message{
+		"en": "This image has already been uploaded for the product",
+		"id": "Gambar ini sudah diunggah untuk produk tersebut",
+	}
+	FailedGetDuplicateImages = model.Message{
+		"en": "Failed to get duplicate images",
+		"id": "Gagal mendapatkan gambar duplikat",
+	}
 )
@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"golectro-product/internal/constants"
 	proto "golectro-product/internal/delivery/grpc/proto/product"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
 	"golectro-product/internal/usecase"
 	"golectro-product/internal/utils"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -24,7 +28,7 @@ func (h *ProductHandler) GetProductById(ctx context.Context, req *proto.GetProdu
 		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
 	}
 
-	product, err := h.ProductUseCase.GetProductByID(ctx, productID)
+	product, err := h.ProductUseCase.GetProductIncludingDeleted(ctx, productID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: %v", constants.FailedGetProductByID, err)
 	}
@@ -34,16 +38,25 @@ func (h *ProductHandler) GetProductById(ctx context.Context, req *proto.GetProdu
 	}
 
 	return &proto.GetProductByIdResponse{
-		Id:          product.ID.String(),
-		Name:        product.Name,
-		Description: product.Description,
-		Category:    string(product.Category),
-		Brand:       product.Brand,
-		Color:       string(product.Color),
-		Specs:       string(product.Specs),
-		Price:       product.Price,
-		Quantity:    int32(product.Quantity),
-		CreatedBy:   product.CreatedBy.String(),
+		Id:                  product.ID.String(),
+		Name:                product.Name,
+		Description:         product.Description,
+		Category:            string(product.Category),
+		Brand:               product.Brand,
+		Color:               string(product.Color),
+		Specs:               string(product.Specs),
+		Price:               majorUnits(product.Price, product.Currency),
+		Quantity:            int32(product.Quantity),
+		CreatedBy:           product.CreatedBy.String(),
+		Slug:                product.Slug,
+		Deleted:             product.DeletedAt != nil,
+		EffectivePrice:      majorUnits(product.EffectivePrice, product.Currency),
+		CompareAtPrice:      optionalMajorUnits(product.CompareAtPrice, product.Currency),
+		PriceCampaignId:     uuidString(product.PriceCampaignID),
+		Currency:            converter.SourceCurrency(product.Currency),
+		PriceMoney:          toProtoMoney(product.Price, product.Currency),
+		EffectivePriceMoney: toProtoMoney(product.EffectivePrice, product.Currency),
+		CompareAtPriceMoney: toOptionalProtoMoney(product.CompareAtPrice, product.Currency),
 	}, nil
 }
 
@@ -69,16 +82,25 @@ func (h *ProductHandler) GetProductByIds(ctx context.Context, req *proto.GetProd
 	response := &proto.GetProductByIdsResponse{}
 	for _, product := range products {
 		response.Products = append(response.Products, &proto.GetProductByIdResponse{
-			Id:          product.ID.String(),
-			Name:        product.Name,
-			Description: product.Description,
-			Category:    string(product.Category),
-			Brand:       product.Brand,
-			Color:       string(product.Color),
-			Specs:       string(product.Specs),
-			Price:       product.Price,
-			Quantity:    int32(product.Quantity),
-			CreatedBy:   product.CreatedBy.String(),
+			Id:                  product.ID.String(),
+			Name:                product.Name,
+			Description:         product.Description,
+			Category:            string(product.Category),
+			Brand:               product.Brand,
+			Color:               string(product.Color),
+			Specs:               string(product.Specs),
+			Price:               majorUnits(product.Price, product.Currency),
+			Quantity:            int32(product.Quantity),
+			CreatedBy:           product.CreatedBy.String(),
+			Slug:                product.Slug,
+			Deleted:             product.DeletedAt != nil,
+			EffectivePrice:      majorUnits(product.EffectivePrice, product.Currency),
+			CompareAtPrice:      optionalMajorUnits(product.CompareAtPrice, product.Currency),
+			PriceCampaignId:     uuidString(product.PriceCampaignID),
+			Currency:            converter.SourceCurrency(product.Currency),
+			PriceMoney:          toProtoMoney(product.Price, product.Currency),
+			EffectivePriceMoney: toProtoMoney(product.EffectivePrice, product.Currency),
+			CompareAtPriceMoney: toOptionalProtoMoney(product.CompareAtPrice, product.Currency),
 		})
 	}
 
@@ -91,15 +113,27 @@ func (h *ProductHandler) DecreaseQuantity(ctx context.Context, req *proto.Decrea
 		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
 	}
 
-	quantity, err := h.ProductUseCase.DecreaseProductQuantity(ctx, productID, int(req.Quantity))
+	userID, err := optionalUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	if req.Quantity <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", constants.InvalidDecreaseQuantity)
+	}
+
+	result, err := h.ProductUseCase.DecreaseProductQuantity(ctx, productID, int(req.Quantity), userID, strings.ToLower(req.CustomerGroup))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: %v", constants.FailedDecreaseProductQuantity, err)
 	}
 
 	return &proto.DecreaseQuantityResponse{
-		Success:     true,
-		Message:     "Product quantity decreased successfully",
-		NewQuantity: int32(quantity),
+		Success:        true,
+		Message:        "Product quantity decreased successfully",
+		NewQuantity:    result.NewQuantity,
+		UnitPrice:      majorUnits(result.UnitPrice, result.Currency),
+		FlashSaleId:    uuidString(result.FlashSaleID),
+		UnitPriceMoney: toProtoMoney(result.UnitPrice, result.Currency),
 	}, nil
 }
 
@@ -108,6 +142,11 @@ func (h *ProductHandler) DecreaseQuantityByIds(ctx context.Context, req *proto.D
 		return nil, status.Errorf(codes.InvalidArgument, "no product items provided")
 	}
 
+	userID, err := optionalUUID(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
 	var results []*proto.DecreaseQuantityResult
 	allSuccess := true
 
@@ -123,7 +162,17 @@ func (h *ProductHandler) DecreaseQuantityByIds(ctx context.Context, req *proto.D
 			continue
 		}
 
-		newQty, err := h.ProductUseCase.DecreaseProductQuantity(ctx, productID, int(item.Quantity))
+		if item.Quantity <= 0 {
+			allSuccess = false
+			results = append(results, &proto.DecreaseQuantityResult{
+				ProductId: item.ProductId,
+				Success:   false,
+				Message:   fmt.Sprintf("invalid quantity at index %d: %d", i, item.Quantity),
+			})
+			continue
+		}
+
+		result, err := h.ProductUseCase.DecreaseProductQuantity(ctx, productID, int(item.Quantity), userID, strings.ToLower(req.CustomerGroup))
 		if err != nil {
 			allSuccess = false
 			results = append(results, &proto.DecreaseQuantityResult{
@@ -135,10 +184,13 @@ func (h *ProductHandler) DecreaseQuantityByIds(ctx context.Context, req *proto.D
 		}
 
 		results = append(results, &proto.DecreaseQuantityResult{
-			ProductId:   item.ProductId,
-			Success:     true,
-			NewQuantity: int32(newQty),
-			Message:     "quantity decreased successfully",
+			ProductId:      item.ProductId,
+			Success:        true,
+			NewQuantity:    result.NewQuantity,
+			UnitPrice:      majorUnits(result.UnitPrice, result.Currency),
+			FlashSaleId:    uuidString(result.FlashSaleID),
+			UnitPriceMoney: toProtoMoney(result.UnitPrice, result.Currency),
+			Message:        "quantity decreased successfully",
 		})
 	}
 
@@ -153,3 +205,129 @@ func (h *ProductHandler) DecreaseQuantityByIds(ctx context.Context, req *proto.D
 		Results: results,
 	}, nil
 }
+
+func (h *ProductHandler) QuotePrice(ctx context.Context, req *proto.QuotePriceRequest) (*proto.QuotePriceResponse, error) {
+	productID, err := utils.ParseUUID(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product ID: %v", err)
+	}
+
+	if req.Quantity <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", constants.InvalidQuoteQuantity)
+	}
+
+	quote, err := h.ProductUseCase.QuotePrice(ctx, productID, int(req.Quantity), strings.ToLower(req.CustomerGroup))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %v", constants.FailedQuotePrice, err)
+	}
+
+	return &proto.QuotePriceResponse{
+		ProductId:       quote.ProductID.String(),
+		Quantity:        int32(quote.Quantity),
+		CustomerGroup:   quote.CustomerGroup,
+		Currency:        quote.Currency,
+		ListPrice:       majorUnits(quote.ListPrice, quote.Currency),
+		UnitPrice:       majorUnits(quote.UnitPrice, quote.Currency),
+		Total:           majorUnits(quote.Total, quote.Currency),
+		Source:          quote.Source,
+		PriceCampaignId: uuidString(quote.PriceCampaignID),
+		PriceListId:     uuidString(quote.PriceListID),
+		PriceTierId:     uuidString(quote.PriceTierID),
+		ListPriceMoney:  toProtoMoney(quote.ListPrice, quote.Currency),
+		UnitPriceMoney:  toProtoMoney(quote.UnitPrice, quote.Currency),
+		TotalMoney:      toProtoMoney(quote.Total, quote.Currency),
+	}, nil
+}
+
+func (h *ProductHandler) GetRelatedProducts(ctx context.Context, req *proto.GetRelatedProductsRequest) (*proto.GetRelatedProductsResponse, error) {
+	if len(req.ProductIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", constants.InvalidProductID)
+	}
+
+	productUUIDs := make([]uuid.UUID, len(req.ProductIds))
+	for i, id := range req.ProductIds {
+		parsedID, err := utils.ParseUUID(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid product ID at index %d: %v", i, err)
+		}
+		productUUIDs[i] = parsedID
+	}
+
+	for _, relationType := range req.Types {
+		if !slices.Contains(model.ProductRelationTypes, relationType) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %s", constants.InvalidProductRelationType, relationType)
+		}
+	}
+
+	locale := strings.ToLower(req.Locale)
+	if !slices.Contains(model.SupportedLocales, locale) {
+		locale = model.DefaultLocale
+	}
+
+	related, err := h.ProductUseCase.GetRelatedProducts(ctx, productUUIDs, req.Types, int(max(req.Limit, 0)), locale)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %v", constants.FailedGetRelatedProducts, err)
+	}
+
+	response := &proto.GetRelatedProductsResponse{}
+	for _, product := range related {
+		response.Products = append(response.Products, &proto.RelatedProduct{
+			Id:                  product.ID.String(),
+			SourceProductId:     product.SourceProductID.String(),
+			RelationType:        product.RelationType,
+			Position:            int32(product.Position),
+			Name:                product.Name,
+			Slug:                product.Slug,
+			Brand:               product.Brand,
+			Price:               majorUnits(product.Price, product.Currency),
+			EffectivePrice:      majorUnits(product.EffectivePrice, product.Currency),
+			Quantity:            int32(product.Quantity),
+			Currency:            converter.SourceCurrency(product.Currency),
+			PriceMoney:          toProtoMoney(product.Price, product.Currency),
+			EffectivePriceMoney: toProtoMoney(product.EffectivePrice, product.Currency),
+		})
+	}
+
+	return response, nil
+}
+
+func majorUnits(amount int64, currency string) float64 {
+	return utils.MoneyValue(utils.ToMoney(amount, converter.SourceCurrency(currency)))
+}
+
+func optionalMajorUnits(amount *int64, currency string) float64 {
+	if amount == nil {
+		return 0
+	}
+	return majorUnits(*amount, currency)
+}
+
+func toProtoMoney(amount int64, currency string) *proto.Money {
+	money := utils.ToMoney(amount, converter.SourceCurrency(currency))
+	return &proto.Money{
+		Amount:   money.Amount,
+		Currency: money.Currency,
+		Exponent: int32(money.Exponent),
+	}
+}
+
+func toOptionalProtoMoney(amount *int64, currency string) *proto.Money {
+	if amount == nil {
+		return nil
+	}
+	return toProtoMoney(*amount, currency)
+}
+
+func uuidString(value *uuid.UUID) string {
+	if value == nil {
+		return ""
+	}
+	return value.String()
+}
+
+func optionalUUID(value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, nil
+	}
+	return utils.ParseUUID(value)
+}
@@ -66,19 +66,31 @@ func (x *GetProductByIdRequest) GetId() string {
 }
 
 type GetProductByIdResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	Brand         string                 `protobuf:"bytes,5,opt,name=brand,proto3" json:"brand,omitempty"`
-	Color         string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
-	Specs         string                 `protobuf:"bytes,7,opt,name=specs,proto3" json:"specs,omitempty"`
-	Price         float64                `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category    string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Brand       string                 `protobuf:"bytes,5,opt,name=brand,proto3" json:"brand,omitempty"`
+	Color       string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	Specs       string                 `protobuf:"bytes,7,opt,name=specs,proto3" json:"specs,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	Price     float64 `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity  int32   `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CreatedBy string  `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Slug      string  `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty"`
+	Deleted   bool    `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	EffectivePrice float64 `protobuf:"fixed64,13,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	CompareAtPrice      float64 `protobuf:"fixed64,14,opt,name=compare_at_price,json=compareAtPrice,proto3" json:"compare_at_price,omitempty"`
+	PriceCampaignId     string  `protobuf:"bytes,15,opt,name=price_campaign_id,json=priceCampaignId,proto3" json:"price_campaign_id,omitempty"`
+	Currency            string  `protobuf:"bytes,16,opt,name=currency,proto3" json:"currency,omitempty"`
+	PriceMoney          *Money  `protobuf:"bytes,17,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	EffectivePriceMoney *Money  `protobuf:"bytes,18,opt,name=effective_price_money,json=effectivePriceMoney,proto3" json:"effective_price_money,omitempty"`
+	CompareAtPriceMoney *Money  `protobuf:"bytes,19,opt,name=compare_at_price_money,json=compareAtPriceMoney,proto3" json:"compare_at_price_money,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetProductByIdResponse) Reset() {
@@ -160,6 +172,7 @@ func (x *GetProductByIdResponse) GetSpecs() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product.proto.
 func (x *GetProductByIdResponse) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -181,6 +194,133 @@ func (x *GetProductByIdResponse) GetCreatedBy() string {
 	return ""
 }
 
+func (x *GetProductByIdResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetProductByIdResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in product.proto.
+func (x *GetProductByIdResponse) GetEffectivePrice() float64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in product.proto.
+func (x *GetProductByIdResponse) GetCompareAtPrice() float64 {
+	if x != nil {
+		return x.CompareAtPrice
+	}
+	return 0
+}
+
+func (x *GetProductByIdResponse) GetPriceCampaignId() string {
+	if x != nil {
+		return x.PriceCampaignId
+	}
+	return ""
+}
+
+func (x *GetProductByIdResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetProductByIdResponse) GetPriceMoney() *Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+func (x *GetProductByIdResponse) GetEffectivePriceMoney() *Money {
+	if x != nil {
+		return x.EffectivePriceMoney
+	}
+	return nil
+}
+
+func (x *GetProductByIdResponse) GetCompareAtPriceMoney() *Money {
+	if x != nil {
+		return x.CompareAtPriceMoney
+	}
+	return nil
+}
+
+// Money carries an amount in minor units; the double price fields are kept
+// for older consumers and hold the same amount in major units.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Exponent      int32                  `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Money) GetExponent() int32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
 type GetProductByIdsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
@@ -190,7 +330,7 @@ type GetProductByIdsRequest struct {
 
 func (x *GetProductByIdsRequest) Reset() {
 	*x = GetProductByIdsRequest{}
-	mi := &file_product_proto_msgTypes[2]
+	mi := &file_product_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -202,7 +342,7 @@ func (x *GetProductByIdsRequest) String() string {
 func (*GetProductByIdsRequest) ProtoMessage() {}
 
 func (x *GetProductByIdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[2]
+	mi := &file_product_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +355,7 @@ func (x *GetProductByIdsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductByIdsRequest.ProtoReflect.Descriptor instead.
 func (*GetProductByIdsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{2}
+	return file_product_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetProductByIdsRequest) GetIds() []string {
@@ -234,7 +374,7 @@ type GetProductByIdsResponse struct {
 
 func (x *GetProductByIdsResponse) Reset() {
 	*x = GetProductByIdsResponse{}
-	mi := &file_product_proto_msgTypes[3]
+	mi := &file_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -246,7 +386,7 @@ func (x *GetProductByIdsResponse) String() string {
 func (*GetProductByIdsResponse) ProtoMessage() {}
 
 func (x *GetProductByIdsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[3]
+	mi := &file_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,7 +399,7 @@ func (x *GetProductByIdsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductByIdsResponse.ProtoReflect.Descriptor instead.
 func (*GetProductByIdsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{3}
+	return file_product_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetProductByIdsResponse) GetProducts() []*GetProductByIdResponse {
@@ -273,13 +413,15 @@ type DecreaseQuantityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CustomerGroup string                 `protobuf:"bytes,4,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DecreaseQuantityRequest) Reset() {
 	*x = DecreaseQuantityRequest{}
-	mi := &file_product_proto_msgTypes[4]
+	mi := &file_product_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -291,7 +433,7 @@ func (x *DecreaseQuantityRequest) String() string {
 func (*DecreaseQuantityRequest) ProtoMessage() {}
 
 func (x *DecreaseQuantityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[4]
+	mi := &file_product_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +446,7 @@ func (x *DecreaseQuantityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecreaseQuantityRequest.ProtoReflect.Descriptor instead.
 func (*DecreaseQuantityRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{4}
+	return file_product_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DecreaseQuantityRequest) GetProductId() string {
@@ -321,18 +463,36 @@ func (x *DecreaseQuantityRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *DecreaseQuantityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DecreaseQuantityRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
 type DecreaseQuantityResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	NewQuantity   int32                  `protobuf:"varint,2,opt,name=new_quantity,json=newQuantity,proto3" json:"new_quantity,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Success     bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	NewQuantity int32                  `protobuf:"varint,2,opt,name=new_quantity,json=newQuantity,proto3" json:"new_quantity,omitempty"`
+	Message     string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	UnitPrice      float64 `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	FlashSaleId    string  `protobuf:"bytes,5,opt,name=flash_sale_id,json=flashSaleId,proto3" json:"flash_sale_id,omitempty"`
+	UnitPriceMoney *Money  `protobuf:"bytes,6,opt,name=unit_price_money,json=unitPriceMoney,proto3" json:"unit_price_money,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DecreaseQuantityResponse) Reset() {
 	*x = DecreaseQuantityResponse{}
-	mi := &file_product_proto_msgTypes[5]
+	mi := &file_product_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -344,7 +504,7 @@ func (x *DecreaseQuantityResponse) String() string {
 func (*DecreaseQuantityResponse) ProtoMessage() {}
 
 func (x *DecreaseQuantityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[5]
+	mi := &file_product_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +517,7 @@ func (x *DecreaseQuantityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecreaseQuantityResponse.ProtoReflect.Descriptor instead.
 func (*DecreaseQuantityResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{5}
+	return file_product_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DecreaseQuantityResponse) GetSuccess() bool {
@@ -381,16 +541,40 @@ func (x *DecreaseQuantityResponse) GetMessage() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product.proto.
+func (x *DecreaseQuantityResponse) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *DecreaseQuantityResponse) GetFlashSaleId() string {
+	if x != nil {
+		return x.FlashSaleId
+	}
+	return ""
+}
+
+func (x *DecreaseQuantityResponse) GetUnitPriceMoney() *Money {
+	if x != nil {
+		return x.UnitPriceMoney
+	}
+	return nil
+}
+
 type DecreaseQuantityByIdsRequest struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Items         []*DecreaseQuantityItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	UserId        string                  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CustomerGroup string                  `protobuf:"bytes,3,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DecreaseQuantityByIdsRequest) Reset() {
 	*x = DecreaseQuantityByIdsRequest{}
-	mi := &file_product_proto_msgTypes[6]
+	mi := &file_product_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -402,7 +586,7 @@ func (x *DecreaseQuantityByIdsRequest) String() string {
 func (*DecreaseQuantityByIdsRequest) ProtoMessage() {}
 
 func (x *DecreaseQuantityByIdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[6]
+	mi := &file_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +599,7 @@ func (x *DecreaseQuantityByIdsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecreaseQuantityByIdsRequest.ProtoReflect.Descriptor instead.
 func (*DecreaseQuantityByIdsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{6}
+	return file_product_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DecreaseQuantityByIdsRequest) GetItems() []*DecreaseQuantityItem {
@@ -425,6 +609,20 @@ func (x *DecreaseQuantityByIdsRequest) GetItems() []*DecreaseQuantityItem {
 	return nil
 }
 
+func (x *DecreaseQuantityByIdsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DecreaseQuantityByIdsRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
 type DecreaseQuantityItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -435,7 +633,7 @@ type DecreaseQuantityItem struct {
 
 func (x *DecreaseQuantityItem) Reset() {
 	*x = DecreaseQuantityItem{}
-	mi := &file_product_proto_msgTypes[7]
+	mi := &file_product_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +645,7 @@ func (x *DecreaseQuantityItem) String() string {
 func (*DecreaseQuantityItem) ProtoMessage() {}
 
 func (x *DecreaseQuantityItem) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[7]
+	mi := &file_product_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +658,7 @@ func (x *DecreaseQuantityItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecreaseQuantityItem.ProtoReflect.Descriptor instead.
 func (*DecreaseQuantityItem) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{7}
+	return file_product_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DecreaseQuantityItem) GetProductId() string {
@@ -488,7 +686,7 @@ type DecreaseQuantityByIdsResponse struct {
 
 func (x *DecreaseQuantityByIdsResponse) Reset() {
 	*x = DecreaseQuantityByIdsResponse{}
-	mi := &file_product_proto_msgTypes[8]
+	mi := &file_product_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -500,7 +698,7 @@ func (x *DecreaseQuantityByIdsResponse) String() string {
 func (*DecreaseQuantityByIdsResponse) ProtoMessage() {}
 
 func (x *DecreaseQuantityByIdsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[8]
+	mi := &file_product_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,7 +711,7 @@ func (x *DecreaseQuantityByIdsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecreaseQuantityByIdsResponse.ProtoReflect.Descriptor instead.
 func (*DecreaseQuantityByIdsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{8}
+	return file_product_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DecreaseQuantityByIdsResponse) GetSuccess() bool {
@@ -538,18 +736,22 @@ func (x *DecreaseQuantityByIdsResponse) GetResults() []*DecreaseQuantityResult {
 }
 
 type DecreaseQuantityResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	NewQuantity   int32                  `protobuf:"varint,3,opt,name=new_quantity,json=newQuantity,proto3" json:"new_quantity,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProductId   string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Success     bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	NewQuantity int32                  `protobuf:"varint,3,opt,name=new_quantity,json=newQuantity,proto3" json:"new_quantity,omitempty"`
+	Message     string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	UnitPrice      float64 `protobuf:"fixed64,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	FlashSaleId    string  `protobuf:"bytes,6,opt,name=flash_sale_id,json=flashSaleId,proto3" json:"flash_sale_id,omitempty"`
+	UnitPriceMoney *Money  `protobuf:"bytes,7,opt,name=unit_price_money,json=unitPriceMoney,proto3" json:"unit_price_money,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DecreaseQuantityResult) Reset() {
 	*x = DecreaseQuantityResult{}
-	mi := &file_product_proto_msgTypes[9]
+	mi := &file_product_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -561,7 +763,7 @@ func (x *DecreaseQuantityResult) String() string {
 func (*DecreaseQuantityResult) ProtoMessage() {}
 
 func (x *DecreaseQuantityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[9]
+	mi := &file_product_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +776,7 @@ func (x *DecreaseQuantityResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecreaseQuantityResult.ProtoReflect.Descriptor instead.
 func (*DecreaseQuantityResult) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{9}
+	return file_product_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DecreaseQuantityResult) GetProductId() string {
@@ -605,13 +807,505 @@ func (x *DecreaseQuantityResult) GetMessage() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product.proto.
+func (x *DecreaseQuantityResult) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *DecreaseQuantityResult) GetFlashSaleId() string {
+	if x != nil {
+		return x.FlashSaleId
+	}
+	return ""
+}
+
+func (x *DecreaseQuantityResult) GetUnitPriceMoney() *Money {
+	if x != nil {
+		return x.UnitPriceMoney
+	}
+	return nil
+}
+
+type QuotePriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CustomerGroup string                 `protobuf:"bytes,3,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotePriceRequest) Reset() {
+	*x = QuotePriceRequest{}
+	mi := &file_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceRequest) ProtoMessage() {}
+
+func (x *QuotePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceRequest.ProtoReflect.Descriptor instead.
+func (*QuotePriceRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QuotePriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *QuotePriceRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *QuotePriceRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type QuotePriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CustomerGroup string                 `protobuf:"bytes,3,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	ListPrice float64 `protobuf:"fixed64,5,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	UnitPrice float64 `protobuf:"fixed64,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	Total           float64 `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	Source          string  `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	PriceCampaignId string  `protobuf:"bytes,9,opt,name=price_campaign_id,json=priceCampaignId,proto3" json:"price_campaign_id,omitempty"`
+	PriceListId     string  `protobuf:"bytes,10,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	PriceTierId     string  `protobuf:"bytes,11,opt,name=price_tier_id,json=priceTierId,proto3" json:"price_tier_id,omitempty"`
+	UnitPriceMoney  *Money  `protobuf:"bytes,12,opt,name=unit_price_money,json=unitPriceMoney,proto3" json:"unit_price_money,omitempty"`
+	TotalMoney      *Money  `protobuf:"bytes,13,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
+	ListPriceMoney  *Money  `protobuf:"bytes,14,opt,name=list_price_money,json=listPriceMoney,proto3" json:"list_price_money,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuotePriceResponse) Reset() {
+	*x = QuotePriceResponse{}
+	mi := &file_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceResponse) ProtoMessage() {}
+
+func (x *QuotePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceResponse.ProtoReflect.Descriptor instead.
+func (*QuotePriceResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QuotePriceResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *QuotePriceResponse) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *QuotePriceResponse) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+func (x *QuotePriceResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in product.proto.
+func (x *QuotePriceResponse) GetListPrice() float64 {
+	if x != nil {
+		return x.ListPrice
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in product.proto.
+func (x *QuotePriceResponse) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in product.proto.
+func (x *QuotePriceResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *QuotePriceResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *QuotePriceResponse) GetPriceCampaignId() string {
+	if x != nil {
+		return x.PriceCampaignId
+	}
+	return ""
+}
+
+func (x *QuotePriceResponse) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *QuotePriceResponse) GetPriceTierId() string {
+	if x != nil {
+		return x.PriceTierId
+	}
+	return ""
+}
+
+func (x *QuotePriceResponse) GetUnitPriceMoney() *Money {
+	if x != nil {
+		return x.UnitPriceMoney
+	}
+	return nil
+}
+
+func (x *QuotePriceResponse) GetTotalMoney() *Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
+}
+
+func (x *QuotePriceResponse) GetListPriceMoney() *Money {
+	if x != nil {
+		return x.ListPriceMoney
+	}
+	return nil
+}
+
+type GetRelatedProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Types         []string               `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedProductsRequest) Reset() {
+	*x = GetRelatedProductsRequest{}
+	mi := &file_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedProductsRequest) ProtoMessage() {}
+
+func (x *GetRelatedProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRelatedProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *GetRelatedProductsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *GetRelatedProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRelatedProductsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type RelatedProduct struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceProductId string                 `protobuf:"bytes,2,opt,name=source_product_id,json=sourceProductId,proto3" json:"source_product_id,omitempty"`
+	RelationType    string                 `protobuf:"bytes,3,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
+	Position        int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Name            string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Slug            string                 `protobuf:"bytes,6,opt,name=slug,proto3" json:"slug,omitempty"`
+	Brand           string                 `protobuf:"bytes,7,opt,name=brand,proto3" json:"brand,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	Price float64 `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	// Deprecated: Marked as deprecated in product.proto.
+	EffectivePrice      float64 `protobuf:"fixed64,9,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	Quantity            int32   `protobuf:"varint,10,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Currency            string  `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	PriceMoney          *Money  `protobuf:"bytes,12,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	EffectivePriceMoney *Money  `protobuf:"bytes,13,opt,name=effective_price_money,json=effectivePriceMoney,proto3" json:"effective_price_money,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RelatedProduct) Reset() {
+	*x = RelatedProduct{}
+	mi := &file_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedProduct) ProtoMessage() {}
+
+func (x *RelatedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedProduct.ProtoReflect.Descriptor instead.
+func (*RelatedProduct) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RelatedProduct) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RelatedProduct) GetSourceProductId() string {
+	if x != nil {
+		return x.SourceProductId
+	}
+	return ""
+}
+
+func (x *RelatedProduct) GetRelationType() string {
+	if x != nil {
+		return x.RelationType
+	}
+	return ""
+}
+
+func (x *RelatedProduct) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RelatedProduct) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RelatedProduct) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *RelatedProduct) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in product.proto.
+func (x *RelatedProduct) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in product.proto.
+func (x *RelatedProduct) GetEffectivePrice() float64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+func (x *RelatedProduct) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RelatedProduct) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RelatedProduct) GetPriceMoney() *Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+func (x *RelatedProduct) GetEffectivePriceMoney() *Money {
+	if x != nil {
+		return x.EffectivePriceMoney
+	}
+	return nil
+}
+
+type GetRelatedProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*RelatedProduct      `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedProductsResponse) Reset() {
+	*x = GetRelatedProductsResponse{}
+	mi := &file_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedProductsResponse) ProtoMessage() {}
+
+func (x *GetRelatedProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetRelatedProductsResponse) GetProducts() []*RelatedProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
 var File_product_proto protoreflect.FileDescriptor
 
 const file_product_proto_rawDesc = "" +
 	"\n" +
 	"\rproduct.proto\x12\aproduct\"'\n" +
 	"\x15GetProductByIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x8d\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9c\x05\n" +
 	"\x16GetProductByIdResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -619,26 +1313,48 @@ const file_product_proto_rawDesc = "" +
 	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x14\n" +
 	"\x05brand\x18\x05 \x01(\tR\x05brand\x12\x14\n" +
 	"\x05color\x18\x06 \x01(\tR\x05color\x12\x14\n" +
-	"\x05specs\x18\a \x01(\tR\x05specs\x12\x14\n" +
-	"\x05price\x18\b \x01(\x01R\x05price\x12\x1a\n" +
+	"\x05specs\x18\a \x01(\tR\x05specs\x12\x18\n" +
+	"\x05price\x18\b \x01(\x01B\x02\x18\x01R\x05price\x12\x1a\n" +
 	"\bquantity\x18\t \x01(\x05R\bquantity\x12\x1d\n" +
 	"\n" +
 	"created_by\x18\n" +
-	" \x01(\tR\tcreatedBy\"*\n" +
+	" \x01(\tR\tcreatedBy\x12\x12\n" +
+	"\x04slug\x18\v \x01(\tR\x04slug\x12\x18\n" +
+	"\adeleted\x18\f \x01(\bR\adeleted\x12+\n" +
+	"\x0feffective_price\x18\r \x01(\x01B\x02\x18\x01R\x0eeffectivePrice\x12,\n" +
+	"\x10compare_at_price\x18\x0e \x01(\x01B\x02\x18\x01R\x0ecompareAtPrice\x12*\n" +
+	"\x11price_campaign_id\x18\x0f \x01(\tR\x0fpriceCampaignId\x12\x1a\n" +
+	"\bcurrency\x18\x10 \x01(\tR\bcurrency\x12/\n" +
+	"\vprice_money\x18\x11 \x01(\v2\x0e.product.MoneyR\n" +
+	"priceMoney\x12B\n" +
+	"\x15effective_price_money\x18\x12 \x01(\v2\x0e.product.MoneyR\x13effectivePriceMoney\x12C\n" +
+	"\x16compare_at_price_money\x18\x13 \x01(\v2\x0e.product.MoneyR\x13compareAtPriceMoney\"W\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bexponent\x18\x03 \x01(\x05R\bexponent\"*\n" +
 	"\x16GetProductByIdsRequest\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\tR\x03ids\"V\n" +
 	"\x17GetProductByIdsResponse\x12;\n" +
-	"\bproducts\x18\x01 \x03(\v2\x1f.product.GetProductByIdResponseR\bproducts\"T\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1f.product.GetProductByIdResponseR\bproducts\"\x94\x01\n" +
 	"\x17DecreaseQuantityRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"q\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12%\n" +
+	"\x0ecustomer_group\x18\x04 \x01(\tR\rcustomerGroup\"\xf2\x01\n" +
 	"\x18DecreaseQuantityResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12!\n" +
 	"\fnew_quantity\x18\x02 \x01(\x05R\vnewQuantity\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"S\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12!\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x01B\x02\x18\x01R\tunitPrice\x12\"\n" +
+	"\rflash_sale_id\x18\x05 \x01(\tR\vflashSaleId\x128\n" +
+	"\x10unit_price_money\x18\x06 \x01(\v2\x0e.product.MoneyR\x0eunitPriceMoney\"\x93\x01\n" +
 	"\x1cDecreaseQuantityByIdsRequest\x123\n" +
-	"\x05items\x18\x01 \x03(\v2\x1d.product.DecreaseQuantityItemR\x05items\"Q\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.product.DecreaseQuantityItemR\x05items\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
+	"\x0ecustomer_group\x18\x03 \x01(\tR\rcustomerGroup\"Q\n" +
 	"\x14DecreaseQuantityItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
@@ -646,18 +1362,74 @@ const file_product_proto_rawDesc = "" +
 	"\x1dDecreaseQuantityByIdsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
-	"\aresults\x18\x03 \x03(\v2\x1f.product.DecreaseQuantityResultR\aresults\"\x8e\x01\n" +
+	"\aresults\x18\x03 \x03(\v2\x1f.product.DecreaseQuantityResultR\aresults\"\x8f\x02\n" +
 	"\x16DecreaseQuantityResult\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12!\n" +
 	"\fnew_quantity\x18\x03 \x01(\x05R\vnewQuantity\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage2\xfa\x02\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12!\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x01B\x02\x18\x01R\tunitPrice\x12\"\n" +
+	"\rflash_sale_id\x18\x06 \x01(\tR\vflashSaleId\x128\n" +
+	"\x10unit_price_money\x18\a \x01(\v2\x0e.product.MoneyR\x0eunitPriceMoney\"u\n" +
+	"\x11QuotePriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12%\n" +
+	"\x0ecustomer_group\x18\x03 \x01(\tR\rcustomerGroup\"\xa3\x04\n" +
+	"\x12QuotePriceResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12%\n" +
+	"\x0ecustomer_group\x18\x03 \x01(\tR\rcustomerGroup\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12!\n" +
+	"\n" +
+	"list_price\x18\x05 \x01(\x01B\x02\x18\x01R\tlistPrice\x12!\n" +
+	"\n" +
+	"unit_price\x18\x06 \x01(\x01B\x02\x18\x01R\tunitPrice\x12\x18\n" +
+	"\x05total\x18\a \x01(\x01B\x02\x18\x01R\x05total\x12\x16\n" +
+	"\x06source\x18\b \x01(\tR\x06source\x12*\n" +
+	"\x11price_campaign_id\x18\t \x01(\tR\x0fpriceCampaignId\x12\"\n" +
+	"\rprice_list_id\x18\n" +
+	" \x01(\tR\vpriceListId\x12\"\n" +
+	"\rprice_tier_id\x18\v \x01(\tR\vpriceTierId\x128\n" +
+	"\x10unit_price_money\x18\f \x01(\v2\x0e.product.MoneyR\x0eunitPriceMoney\x12/\n" +
+	"\vtotal_money\x18\r \x01(\v2\x0e.product.MoneyR\n" +
+	"totalMoney\x128\n" +
+	"\x10list_price_money\x18\x0e \x01(\v2\x0e.product.MoneyR\x0elistPriceMoney\"\x80\x01\n" +
+	"\x19GetRelatedProductsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12\x14\n" +
+	"\x05types\x18\x02 \x03(\tR\x05types\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06locale\x18\x04 \x01(\tR\x06locale\"\xbf\x03\n" +
+	"\x0eRelatedProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11source_product_id\x18\x02 \x01(\tR\x0fsourceProductId\x12#\n" +
+	"\rrelation_type\x18\x03 \x01(\tR\frelationType\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x06 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05brand\x18\a \x01(\tR\x05brand\x12\x18\n" +
+	"\x05price\x18\b \x01(\x01B\x02\x18\x01R\x05price\x12+\n" +
+	"\x0feffective_price\x18\t \x01(\x01B\x02\x18\x01R\x0eeffectivePrice\x12\x1a\n" +
+	"\bquantity\x18\n" +
+	" \x01(\x05R\bquantity\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12/\n" +
+	"\vprice_money\x18\f \x01(\v2\x0e.product.MoneyR\n" +
+	"priceMoney\x12B\n" +
+	"\x15effective_price_money\x18\r \x01(\v2\x0e.product.MoneyR\x13effectivePriceMoney\"Q\n" +
+	"\x1aGetRelatedProductsResponse\x123\n" +
+	"\bproducts\x18\x01 \x03(\v2\x17.product.RelatedProductR\bproducts2\xa0\x04\n" +
 	"\x0eProductService\x12Q\n" +
 	"\x0eGetProductById\x12\x1e.product.GetProductByIdRequest\x1a\x1f.product.GetProductByIdResponse\x12T\n" +
 	"\x0fGetProductByIds\x12\x1f.product.GetProductByIdsRequest\x1a .product.GetProductByIdsResponse\x12W\n" +
 	"\x10DecreaseQuantity\x12 .product.DecreaseQuantityRequest\x1a!.product.DecreaseQuantityResponse\x12f\n" +
-	"\x15DecreaseQuantityByIds\x12%.product.DecreaseQuantityByIdsRequest\x1a&.product.DecreaseQuantityByIdsResponseB?Z=golectro-product/internal/delivery/grpc/proto/product;productb\x06proto3"
+	"\x15DecreaseQuantityByIds\x12%.product.DecreaseQuantityByIdsRequest\x1a&.product.DecreaseQuantityByIdsResponse\x12E\n" +
+	"\n" +
+	"QuotePrice\x12\x1a.product.QuotePriceRequest\x1a\x1b.product.QuotePriceResponse\x12]\n" +
+	"\x12GetRelatedProducts\x12\".product.GetRelatedProductsRequest\x1a#.product.GetRelatedProductsResponseB?Z=golectro-product/internal/delivery/grpc/proto/product;productb\x06proto3"
 
 var (
 	file_product_proto_rawDescOnce sync.Once
@@ -671,36 +1443,57 @@ func file_product_proto_rawDescGZIP() []byte {
 	return file_product_proto_rawDescData
 }
 
-var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_product_proto_goTypes = []any{
 	(*GetProductByIdRequest)(nil),         // 0: product.GetProductByIdRequest
 	(*GetProductByIdResponse)(nil),        // 1: product.GetProductByIdResponse
-	(*GetProductByIdsRequest)(nil),        // 2: product.GetProductByIdsRequest
-	(*GetProductByIdsResponse)(nil),       // 3: product.GetProductByIdsResponse
-	(*DecreaseQuantityRequest)(nil),       // 4: product.DecreaseQuantityRequest
-	(*DecreaseQuantityResponse)(nil),      // 5: product.DecreaseQuantityResponse
-	(*DecreaseQuantityByIdsRequest)(nil),  // 6: product.DecreaseQuantityByIdsRequest
-	(*DecreaseQuantityItem)(nil),          // 7: product.DecreaseQuantityItem
-	(*DecreaseQuantityByIdsResponse)(nil), // 8: product.DecreaseQuantityByIdsResponse
-	(*DecreaseQuantityResult)(nil),        // 9: product.DecreaseQuantityResult
+	(*Money)(nil),                         // 2: product.Money
+	(*GetProductByIdsRequest)(nil),        // 3: product.GetProductByIdsRequest
+	(*GetProductByIdsResponse)(nil),       // 4: product.GetProductByIdsResponse
+	(*DecreaseQuantityRequest)(nil),       // 5: product.DecreaseQuantityRequest
+	(*DecreaseQuantityResponse)(nil),      // 6: product.DecreaseQuantityResponse
+	(*DecreaseQuantityByIdsRequest)(nil),  // 7: product.DecreaseQuantityByIdsRequest
+	(*DecreaseQuantityItem)(nil),          // 8: product.DecreaseQuantityItem
+	(*DecreaseQuantityByIdsResponse)(nil), // 9: product.DecreaseQuantityByIdsResponse
+	(*DecreaseQuantityResult)(nil),        // 10: product.DecreaseQuantityResult
+	(*QuotePriceRequest)(nil),             // 11: product.QuotePriceRequest
+	(*QuotePriceResponse)(nil),            // 12: product.QuotePriceResponse
+	(*GetRelatedProductsRequest)(nil),     // 13: product.GetRelatedProductsRequest
+	(*RelatedProduct)(nil),                // 14: product.RelatedProduct
+	(*GetRelatedProductsResponse)(nil),    // 15: product.GetRelatedProductsResponse
 }
 var file_product_proto_depIdxs = []int32{
-	1, // 0: product.GetProductByIdsResponse.products:type_name -> product.GetProductByIdResponse
-	7, // 1: product.DecreaseQuantityByIdsRequest.items:type_name -> product.DecreaseQuantityItem
-	9, // 2: product.DecreaseQuantityByIdsResponse.results:type_name -> product.DecreaseQuantityResult
-	0, // 3: product.ProductService.GetProductById:input_type -> product.GetProductByIdRequest
-	2, // 4: product.ProductService.GetProductByIds:input_type -> product.GetProductByIdsRequest
-	4, // 5: product.ProductService.DecreaseQuantity:input_type -> product.DecreaseQuantityRequest
-	6, // 6: product.ProductService.DecreaseQuantityByIds:input_type -> product.DecreaseQuantityByIdsRequest
-	1, // 7: product.ProductService.GetProductById:output_type -> product.GetProductByIdResponse
-	3, // 8: product.ProductService.GetProductByIds:output_type -> product.GetProductByIdsResponse
-	5, // 9: product.ProductService.DecreaseQuantity:output_type -> product.DecreaseQuantityResponse
-	8, // 10: product.ProductService.DecreaseQuantityByIds:output_type -> product.DecreaseQuantityByIdsResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: product.GetProductByIdResponse.price_money:type_name -> product.Money
+	2,  // 1: product.GetProductByIdResponse.effective_price_money:type_name -> product.Money
+	2,  // 2: product.GetProductByIdResponse.compare_at_price_money:type_name -> product.Money
+	1,  // 3: product.GetProductByIdsResponse.products:type_name -> product.GetProductByIdResponse
+	2,  // 4: product.DecreaseQuantityResponse.unit_price_money:type_name -> product.Money
+	8,  // 5: product.DecreaseQuantityByIdsRequest.items:type_name -> product.DecreaseQuantityItem
+	10, // 6: product.DecreaseQuantityByIdsResponse.results:type_name -> product.DecreaseQuantityResult
+	2,  // 7: product.DecreaseQuantityResult.unit_price_money:type_name -> product.Money
+	2,  // 8: product.QuotePriceResponse.unit_price_money:type_name -> product.Money
+	2,  // 9: product.QuotePriceResponse.total_money:type_name -> product.Money
+	2,  // 10: product.QuotePriceResponse.list_price_money:type_name -> product.Money
+	2,  // 11: product.RelatedProduct.price_money:type_name -> product.Money
+	2,  // 12: product.RelatedProduct.effective_price_money:type_name -> product.Money
+	14, // 13: product.GetRelatedProductsResponse.products:type_name -> product.RelatedProduct
+	0,  // 14: product.ProductService.GetProductById:input_type -> product.GetProductByIdRequest
+	3,  // 15: product.ProductService.GetProductByIds:input_type -> product.GetProductByIdsRequest
+	5,  // 16: product.ProductService.DecreaseQuantity:input_type -> product.DecreaseQuantityRequest
+	7,  // 17: product.ProductService.DecreaseQuantityByIds:input_type -> product.DecreaseQuantityByIdsRequest
+	11, // 18: product.ProductService.QuotePrice:input_type -> product.QuotePriceRequest
+	13, // 19: product.ProductService.GetRelatedProducts:input_type -> product.GetRelatedProductsRequest
+	1,  // 20: product.ProductService.GetProductById:output_type -> product.GetProductByIdResponse
+	4,  // 21: product.ProductService.GetProductByIds:output_type -> product.GetProductByIdsResponse
+	6,  // 22: product.ProductService.DecreaseQuantity:output_type -> product.DecreaseQuantityResponse
+	9,  // 23: product.ProductService.DecreaseQuantityByIds:output_type -> product.DecreaseQuantityByIdsResponse
+	12, // 24: product.ProductService.QuotePrice:output_type -> product.QuotePriceResponse
+	15, // 25: product.ProductService.GetRelatedProducts:output_type -> product.GetRelatedProductsResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -714,7 +1507,7 @@ func file_product_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_proto_rawDesc), len(file_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,8 @@ const (
 	ProductService_GetProductByIds_FullMethodName       = "/product.ProductService/GetProductByIds"
 	ProductService_DecreaseQuantity_FullMethodName      = "/product.ProductService/DecreaseQuantity"
 	ProductService_DecreaseQuantityByIds_FullMethodName = "/product.ProductService/DecreaseQuantityByIds"
+	ProductService_QuotePrice_FullMethodName            = "/product.ProductService/QuotePrice"
+	ProductService_GetRelatedProducts_FullMethodName    = "/product.ProductService/GetRelatedProducts"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -33,6 +35,8 @@ type ProductServiceClient interface {
 	GetProductByIds(ctx context.Context, in *GetProductByIdsRequest, opts ...grpc.CallOption) (*GetProductByIdsResponse, error)
 	DecreaseQuantity(ctx context.Context, in *DecreaseQuantityRequest, opts ...grpc.CallOption) (*DecreaseQuantityResponse, error)
 	DecreaseQuantityByIds(ctx context.Context, in *DecreaseQuantityByIdsRequest, opts ...grpc.CallOption) (*DecreaseQuantityByIdsResponse, error)
+	QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*QuotePriceResponse, error)
+	GetRelatedProducts(ctx context.Context, in *GetRelatedProductsRequest, opts ...grpc.CallOption) (*GetRelatedProductsResponse, error)
 }
 
 type productServiceClient struct {
@@ -83,6 +87,26 @@ func (c *productServiceClient) DecreaseQuantityByIds(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *productServiceClient) QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*QuotePriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotePriceResponse)
+	err := c.cc.Invoke(ctx, ProductService_QuotePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetRelatedProducts(ctx context.Context, in *GetRelatedProductsRequest, opts ...grpc.CallOption) (*GetRelatedProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelatedProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetRelatedProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -91,6 +115,8 @@ type ProductServiceServer interface {
 	GetProductByIds(context.Context, *GetProductByIdsRequest) (*GetProductByIdsResponse, error)
 	DecreaseQuantity(context.Context, *DecreaseQuantityRequest) (*DecreaseQuantityResponse, error)
 	DecreaseQuantityByIds(context.Context, *DecreaseQuantityByIdsRequest) (*DecreaseQuantityByIdsResponse, error)
+	QuotePrice(context.Context, *QuotePriceRequest) (*QuotePriceResponse, error)
+	GetRelatedProducts(context.Context, *GetRelatedProductsRequest) (*GetRelatedProductsResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -113,6 +139,12 @@ func (UnimplementedProductServiceServer) DecreaseQuantity(context.Context, *Decr
 func (UnimplementedProductServiceServer) DecreaseQuantityByIds(context.Context, *DecreaseQuantityByIdsRequest) (*DecreaseQuantityByIdsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecreaseQuantityByIds not implemented")
 }
+func (UnimplementedProductServiceServer) QuotePrice(context.Context, *QuotePriceRequest) (*QuotePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePrice not implemented")
+}
+func (UnimplementedProductServiceServer) GetRelatedProducts(context.Context, *GetRelatedProductsRequest) (*GetRelatedProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedProducts not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -206,6 +238,42 @@ func _ProductService_DecreaseQuantityByIds_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_QuotePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).QuotePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_QuotePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).QuotePrice(ctx, req.(*QuotePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetRelatedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetRelatedProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetRelatedProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetRelatedProducts(ctx, req.(*GetRelatedProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +297,14 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DecreaseQuantityByIds",
 			Handler:    _ProductService_DecreaseQuantityByIds_Handler,
 		},
+		{
+			MethodName: "QuotePrice",
+			Handler:    _ProductService_QuotePrice_Handler,
+		},
+		{
+			MethodName: "GetRelatedProducts",
+			Handler:    _ProductService_GetRelatedProducts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "product.proto",
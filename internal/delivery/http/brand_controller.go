@@ -0,0 +1,219 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type BrandController struct {
+	Log          *logrus.Logger
+	BrandUseCase *usecase.BrandUseCase
+}
+
+func NewBrandController(brandUseCase *usecase.BrandUseCase, log *logrus.Logger) *BrandController {
+	return &BrandController{
+		Log:          log,
+		BrandUseCase: brandUseCase,
+	}
+}
+
+func (c *BrandController) GetAllBrands(ctx *gin.Context) {
+	brands, err := c.BrandUseCase.GetAllBrands(ctx)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get brands")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetBrands, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetBrands, brands)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *BrandController) GetBrandByID(ctx *gin.Context) {
+	brandUUID, ok := parseIDParam(ctx, c.Log, "brandID", constants.InvalidBrandID, constants.InvalidBrandIDFormat)
+	if !ok {
+		return
+	}
+
+	brand, err := c.BrandUseCase.GetBrandByID(ctx, brandUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get brand by ID")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetBrandByID, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetBrandByID, brand)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *BrandController) CreateBrand(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	request := new(model.BrandRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.BrandUseCase.CreateBrand(ctx, request)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to create brand")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedCreateBrand, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessCreateBrand, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *BrandController) UpdateBrand(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	brandUUID, ok := parseIDParam(ctx, c.Log, "brandID", constants.InvalidBrandID, constants.InvalidBrandIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.BrandRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.BrandUseCase.UpdateBrand(ctx, brandUUID, request)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update brand")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdateBrand, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateBrand, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *BrandController) DeleteBrand(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	brandUUID, ok := parseIDParam(ctx, c.Log, "brandID", constants.InvalidBrandID, constants.InvalidBrandIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.BrandUseCase.DeleteBrand(ctx, brandUUID); err != nil {
+		c.Log.WithError(err).Error("Failed to delete brand")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedDeleteBrand, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeleteBrand, true)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *BrandController) UploadBrandLogo(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	brandUUID, ok := parseIDParam(ctx, c.Log, "brandID", constants.InvalidBrandID, constants.InvalidBrandIDFormat)
+	if !ok {
+		return
+	}
+
+	uploadedFileAny, exists := ctx.Get("uploadedFile")
+	if !exists {
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.NoFilesUploaded, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	uploadedFile := uploadedFileAny.(map[string]any)
+	fileName, _ := uploadedFile["file_name"].(string)
+
+	result, err := c.BrandUseCase.UpdateBrandLogo(ctx, brandUUID, fileName)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update brand logo")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedUploadBrandLogo, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUploadBrandLogo, result)
+	ctx.JSON(res.StatusCode, res)
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type BundleController struct {
+	Log           *logrus.Logger
+	BundleUseCase *usecase.BundleUseCase
+}
+
+func NewBundleController(bundleUseCase *usecase.BundleUseCase, log *logrus.Logger) *BundleController {
+	return &BundleController{
+		Log:           log,
+		BundleUseCase: bundleUseCase,
+	}
+}
+
+func (c *BundleController) UpdateBundleComponents(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.BundleComponentsRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.BundleUseCase.UpdateBundleComponents(ctx, productUUID, request)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update bundle components")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdateBundleComponents, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateBundleComponents, result)
+	ctx.JSON(res.StatusCode, res)
+}
@@ -0,0 +1,235 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type CategoryController struct {
+	Log             *logrus.Logger
+	CategoryUseCase *usecase.CategoryUseCase
+}
+
+func NewCategoryController(categoryUseCase *usecase.CategoryUseCase, log *logrus.Logger) *CategoryController {
+	return &CategoryController{
+		Log:             log,
+		CategoryUseCase: categoryUseCase,
+	}
+}
+
+func (c *CategoryController) GetCategoryTree(ctx *gin.Context) {
+	categories, err := c.CategoryUseCase.GetCategoryTree(ctx)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get categories")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetCategories, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetCategories, categories)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *CategoryController) GetCategoryByID(ctx *gin.Context) {
+	categoryUUID, ok := parseIDParam(ctx, c.Log, "categoryID", constants.InvalidCategoryID, constants.InvalidCategoryIDFormat)
+	if !ok {
+		return
+	}
+
+	category, err := c.CategoryUseCase.GetCategoryByID(ctx, categoryUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get category by ID")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetCategoryByID, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetCategoryByID, category)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *CategoryController) CreateCategory(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	request := new(model.CategoryRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.CategoryUseCase.CreateCategory(ctx, request)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to create category")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedCreateCategory, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessCreateCategory, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *CategoryController) UpdateCategory(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	categoryUUID, ok := parseIDParam(ctx, c.Log, "categoryID", constants.InvalidCategoryID, constants.InvalidCategoryIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.CategoryRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.CategoryUseCase.UpdateCategory(ctx, categoryUUID, request)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update category")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdateCategory, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateCategory, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *CategoryController) DeleteCategory(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	categoryUUID, ok := parseIDParam(ctx, c.Log, "categoryID", constants.InvalidCategoryID, constants.InvalidCategoryIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.CategoryUseCase.DeleteCategory(ctx, categoryUUID); err != nil {
+		c.Log.WithError(err).Error("Failed to delete category")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedDeleteCategory, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeleteCategory, true)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *CategoryController) GetCategorySpecs(ctx *gin.Context) {
+	categoryUUID, ok := parseIDParam(ctx, c.Log, "categoryID", constants.InvalidCategoryID, constants.InvalidCategoryIDFormat)
+	if !ok {
+		return
+	}
+
+	specs, err := c.CategoryUseCase.GetCategorySpecs(ctx, categoryUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get category specs")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetCategorySpecs, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetCategorySpecs, specs)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *CategoryController) ReplaceCategorySpecs(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	categoryUUID, ok := parseIDParam(ctx, c.Log, "categoryID", constants.InvalidCategoryID, constants.InvalidCategoryIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.CategorySpecsRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.CategoryUseCase.ReplaceCategorySpecs(ctx, categoryUUID, request)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update category specs")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdateCategorySpecs, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateCategorySpecs, result)
+	ctx.JSON(res.StatusCode, res)
+}
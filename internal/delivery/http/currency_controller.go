@@ -0,0 +1,78 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type CurrencyController struct {
+	Log             *logrus.Logger
+	CurrencyUseCase *usecase.CurrencyUseCase
+}
+
+func NewCurrencyController(currencyUseCase *usecase.CurrencyUseCase, log *logrus.Logger) *CurrencyController {
+	return &CurrencyController{
+		Log:             log,
+		CurrencyUseCase: currencyUseCase,
+	}
+}
+
+func (c *CurrencyController) GetExchangeRates(ctx *gin.Context) {
+	result, err := c.CurrencyUseCase.GetExchangeRates(ctx)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get exchange rates")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetExchangeRates, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetExchangeRates, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *CurrencyController) UpdateExchangeRates(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	request := new(model.UpdateExchangeRatesRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.CurrencyUseCase.UpdateExchangeRates(ctx, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update exchange rates")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdateExchangeRates, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateExchangeRates, result)
+	ctx.JSON(res.StatusCode, res)
+}
@@ -0,0 +1,173 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type FlashSaleController struct {
+	Log              *logrus.Logger
+	FlashSaleUseCase *usecase.FlashSaleUseCase
+}
+
+func NewFlashSaleController(flashSaleUseCase *usecase.FlashSaleUseCase, log *logrus.Logger) *FlashSaleController {
+	return &FlashSaleController{
+		Log:              log,
+		FlashSaleUseCase: flashSaleUseCase,
+	}
+}
+
+func (c *FlashSaleController) GetFlashSales(ctx *gin.Context) {
+	result, err := c.FlashSaleUseCase.GetFlashSales(ctx)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get flash sales")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetFlashSales, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetFlashSales, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *FlashSaleController) GetFlashSaleByID(ctx *gin.Context) {
+	saleUUID, ok := parseIDParam(ctx, c.Log, "saleID", constants.InvalidFlashSaleID, constants.InvalidFlashSaleIDFormat)
+	if !ok {
+		return
+	}
+
+	result, err := c.FlashSaleUseCase.GetFlashSaleByID(ctx, saleUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get flash sale by ID")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetFlashSaleByID, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetFlashSaleByID, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *FlashSaleController) CreateFlashSale(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	request := new(model.FlashSaleRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.FlashSaleUseCase.CreateFlashSale(ctx, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to create flash sale")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedCreateFlashSale, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessCreateFlashSale, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *FlashSaleController) UpdateFlashSale(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	saleUUID, ok := parseIDParam(ctx, c.Log, "saleID", constants.InvalidFlashSaleID, constants.InvalidFlashSaleIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.FlashSaleRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.FlashSaleUseCase.UpdateFlashSale(ctx, saleUUID, request)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update flash sale")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdateFlashSale, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateFlashSale, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *FlashSaleController) DeleteFlashSale(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	saleUUID, ok := parseIDParam(ctx, c.Log, "saleID", constants.InvalidFlashSaleID, constants.InvalidFlashSaleIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.FlashSaleUseCase.DeleteFlashSale(ctx, saleUUID); err != nil {
+		c.Log.WithError(err).Error("Failed to delete flash sale")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedDeleteFlashSale, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeleteFlashSale, true)
+	ctx.JSON(res.StatusCode, res)
+}
@@ -6,6 +6,7 @@ import (
 	"golectro-product/internal/model"
 	"golectro-product/internal/utils"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/MicahParks/keyfunc/v2"
@@ -85,10 +86,11 @@ func NewAuth(viper *viper.Viper) gin.HandlerFunc {
 		email, _ := claims["email"].(string)
 
 		auth := &model.Auth{
-			ID:       uid,
-			Username: username,
-			Email:    email,
-			Roles:    rolesJSON,
+			ID:            uid,
+			Username:      username,
+			Email:         email,
+			Roles:         rolesJSON,
+			CustomerGroup: resolveCustomerGroup(claims, roles),
 		}
 
 		ctx.Set("auth", auth)
@@ -96,6 +98,29 @@ func NewAuth(viper *viper.Viper) gin.HandlerFunc {
 	}
 }
 
+func resolveCustomerGroup(claims jwt.MapClaims, roles []string) string {
+	if group, ok := claims[model.CustomerGroupClaim].(string); ok && group != "" {
+		return strings.ToLower(group)
+	}
+
+	if groups, ok := claims["groups"].([]any); ok {
+		for _, item := range groups {
+			path, _ := item.(string)
+			if group, ok := strings.CutPrefix(path, model.CustomerGroupPathPrefix); ok && group != "" {
+				return strings.ToLower(group)
+			}
+		}
+	}
+
+	for _, role := range roles {
+		if group, ok := strings.CutPrefix(role, model.CustomerGroupRolePrefix); ok && group != "" {
+			return strings.ToLower(group)
+		}
+	}
+
+	return ""
+}
+
 func GetUser(c *gin.Context) *model.Auth {
 	if val, exists := c.Get("auth"); exists {
 		return val.(*model.Auth)
@@ -1,27 +1,43 @@
 package middleware
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"golectro-product/internal/constants"
+	"golectro-product/internal/model"
+	"golectro-product/internal/repository"
 	"golectro-product/internal/utils"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"slices"
 
 	"github.com/gin-gonic/gin"
 	"github.com/minio/minio-go/v7"
+	"github.com/sirupsen/logrus"
 )
 
 type UploadOptions struct {
-	FieldName     string
-	MaxFileSizeMB int64
-	MaxFiles      int
-	BucketName    string
-	AllowedTypes  []string
+	FieldName        string
+	MaxFileSizeMB    int64
+	MaxFiles         int
+	BucketName       string
+	AllowedTypes     []string
+	MinWidth         int
+	MinHeight        int
+	MaxWidth         int
+	MaxHeight        int
+	MinAspectRatio   float64
+	MaxAspectRatio   float64
+	Scanner          repository.FileScanner
+	QuarantineBucket string
+	Log              *logrus.Logger
 }
 
 func SingleFileUpload(minioClient *minio.Client, opts UploadOptions) gin.HandlerFunc {
@@ -40,36 +56,12 @@ func SingleFileUpload(minioClient *minio.Client, opts UploadOptions) gin.Handler
 			return
 		}
 
-		src, err := file.Open()
-		if err != nil {
-			res := utils.FailedResponse(c, http.StatusInternalServerError, constants.InvalidOpenFile, nil)
-			c.AbortWithStatusJSON(res.StatusCode, res)
-			return
-		}
-		defer src.Close()
-
-		buffer := make([]byte, 512)
-		if _, err := src.Read(buffer); err != nil {
-			res := utils.FailedResponse(c, http.StatusInternalServerError, constants.InvalidReadFile, nil)
+		upload, status, message := readUploadFile(c, minioClient, auth, file, opts)
+		if upload == nil {
+			res := utils.FailedResponse(c, status, message, nil)
 			c.AbortWithStatusJSON(res.StatusCode, res)
 			return
 		}
-		contentType := http.DetectContentType(buffer)
-
-		if _, err := src.Seek(0, io.SeekStart); err != nil {
-			res := utils.FailedResponse(c, http.StatusInternalServerError, constants.InvalidResetPosition, nil)
-			c.AbortWithStatusJSON(res.StatusCode, res)
-			return
-		}
-
-		if len(opts.AllowedTypes) > 0 {
-			allowed := slices.Contains(opts.AllowedTypes, contentType)
-			if !allowed {
-				res := utils.FailedResponse(c, http.StatusInternalServerError, constants.InvalidFileType, nil)
-				c.AbortWithStatusJSON(res.StatusCode, res)
-				return
-			}
-		}
 
 		now := time.Now()
 		ext := filepath.Ext(file.Filename)
@@ -81,17 +73,17 @@ func SingleFileUpload(minioClient *minio.Client, opts UploadOptions) gin.Handler
 			"author_id":     auth.ID.String(),
 			"created_at":    now.Format(time.RFC3339Nano),
 			"updated_at":    now.Format(time.RFC3339Nano),
-			"file_size":     fmt.Sprintf("%d", file.Size),
+			"file_size":     fmt.Sprintf("%d", len(upload.Content)),
 		}
 
 		info, err := minioClient.PutObject(
 			context.Background(),
 			opts.BucketName,
 			uniqueName,
-			src,
-			file.Size,
+			bytes.NewReader(upload.Content),
+			int64(len(upload.Content)),
 			minio.PutObjectOptions{
-				ContentType:  contentType,
+				ContentType:  upload.ContentType,
 				UserMetadata: metadata,
 			},
 		)
@@ -102,13 +94,18 @@ func SingleFileUpload(minioClient *minio.Client, opts UploadOptions) gin.Handler
 		}
 
 		c.Set("uploadedFile", map[string]any{
-			"file_name":     uniqueName,
-			"original_name": file.Filename,
-			"file_size":     file.Size,
-			"url":           fmt.Sprintf("/%s/%s", info.Bucket, info.Key),
-			"author_id":     auth.ID,
-			"created_at":    now,
-			"updated_at":    now,
+			"file_name":       uniqueName,
+			"original_name":   file.Filename,
+			"file_size":       int64(len(upload.Content)),
+			"content_type":    upload.ContentType,
+			"width":           upload.Width,
+			"height":          upload.Height,
+			"content_hash":    upload.ContentHash,
+			"perceptual_hash": upload.PerceptualHash,
+			"url":             fmt.Sprintf("/%s/%s", info.Bucket, info.Key),
+			"author_id":       auth.ID,
+			"created_at":      now,
+			"updated_at":      now,
 		})
 
 		c.Next()
@@ -133,48 +130,23 @@ func MultipleFileUpload(minioClient *minio.Client, opts UploadOptions) gin.Handl
 			return
 		}
 
+		if opts.MaxFiles > 0 && len(files) > opts.MaxFiles {
+			res := utils.FailedResponse(c, http.StatusBadRequest, constants.TooManyFiles, nil)
+			c.AbortWithStatusJSON(res.StatusCode, res)
+			return
+		}
+
 		var uploadedFiles []map[string]any
 
 		for _, file := range files {
-			if file.Size > opts.MaxFileSizeMB*1024*1024 {
-				res := utils.FailedResponse(c, http.StatusBadRequest, constants.FileSizeExceeded, nil)
+			upload, status, message := readUploadFile(c, minioClient, auth, file, opts)
+			if upload == nil {
+				removeUploadedFiles(minioClient, opts.BucketName, uploadedFiles)
+				res := utils.FailedResponse(c, status, message, nil)
 				c.AbortWithStatusJSON(res.StatusCode, res)
 				return
 			}
 
-			src, err := file.Open()
-			if err != nil {
-				res := utils.FailedResponse(c, http.StatusInternalServerError, constants.InvalidOpenFile, nil)
-				c.AbortWithStatusJSON(res.StatusCode, res)
-				return
-			}
-
-			buffer := make([]byte, 512)
-			if _, err := src.Read(buffer); err != nil {
-				src.Close()
-				res := utils.FailedResponse(c, http.StatusInternalServerError, constants.InvalidReadFile, nil)
-				c.AbortWithStatusJSON(res.StatusCode, res)
-				return
-			}
-			contentType := http.DetectContentType(buffer)
-
-			if _, err := src.Seek(0, io.SeekStart); err != nil {
-				src.Close()
-				res := utils.FailedResponse(c, http.StatusInternalServerError, constants.InvalidResetPosition, nil)
-				c.AbortWithStatusJSON(res.StatusCode, res)
-				return
-			}
-
-			if len(opts.AllowedTypes) > 0 {
-				allowed := slices.Contains(opts.AllowedTypes, contentType)
-				if !allowed {
-					src.Close()
-					res := utils.FailedResponse(c, http.StatusInternalServerError, constants.InvalidFileType, nil)
-					c.AbortWithStatusJSON(res.StatusCode, res)
-					return
-				}
-			}
-
 			now := time.Now()
 			ext := filepath.Ext(file.Filename)
 			formattedTime := now.Format("02-01-2006-15-04-05-000")
@@ -185,36 +157,41 @@ func MultipleFileUpload(minioClient *minio.Client, opts UploadOptions) gin.Handl
 				"author_id":     auth.ID.String(),
 				"created_at":    now.Format(time.RFC3339Nano),
 				"updated_at":    now.Format(time.RFC3339Nano),
-				"file_size":     fmt.Sprintf("%d", file.Size),
+				"file_size":     fmt.Sprintf("%d", len(upload.Content)),
 			}
 
 			info, err := minioClient.PutObject(
 				context.Background(),
 				opts.BucketName,
 				uniqueName,
-				src,
-				file.Size,
+				bytes.NewReader(upload.Content),
+				int64(len(upload.Content)),
 				minio.PutObjectOptions{
-					ContentType:  contentType,
+					ContentType:  upload.ContentType,
 					UserMetadata: metadata,
 				},
 			)
-			src.Close()
 
 			if err != nil {
+				removeUploadedFiles(minioClient, opts.BucketName, uploadedFiles)
 				res := utils.FailedResponse(c, http.StatusInternalServerError, constants.FailedUploadObject, nil)
 				c.AbortWithStatusJSON(res.StatusCode, res)
 				return
 			}
 
 			uploadedFiles = append(uploadedFiles, map[string]any{
-				"file_name":     uniqueName,
-				"original_name": file.Filename,
-				"file_size":     file.Size,
-				"url":           fmt.Sprintf("/%s/%s", info.Bucket, info.Key),
-				"author_id":     auth.ID,
-				"created_at":    now,
-				"updated_at":    now,
+				"file_name":       uniqueName,
+				"original_name":   file.Filename,
+				"file_size":       int64(len(upload.Content)),
+				"content_type":    upload.ContentType,
+				"width":           upload.Width,
+				"height":          upload.Height,
+				"content_hash":    upload.ContentHash,
+				"perceptual_hash": upload.PerceptualHash,
+				"url":             fmt.Sprintf("/%s/%s", info.Bucket, info.Key),
+				"author_id":       auth.ID,
+				"created_at":      now,
+				"updated_at":      now,
 			})
 		}
 
@@ -222,3 +199,122 @@ func MultipleFileUpload(minioClient *minio.Client, opts UploadOptions) gin.Handl
 		c.Next()
 	}
 }
+
+func removeUploadedFiles(minioClient *minio.Client, bucket string, files []map[string]any) {
+	for _, file := range files {
+		if fileName, ok := file["file_name"].(string); ok && fileName != "" {
+			_ = minioClient.RemoveObject(context.Background(), bucket, fileName, minio.RemoveObjectOptions{})
+		}
+	}
+}
+
+func readUploadFile(c *gin.Context, minioClient *minio.Client, auth *model.Auth, file *multipart.FileHeader, opts UploadOptions) (*utils.SanitizedImage, int, model.Message) {
+	if file.Size > opts.MaxFileSizeMB*1024*1024 {
+		return nil, http.StatusBadRequest, constants.FileSizeExceeded
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return nil, http.StatusInternalServerError, constants.InvalidOpenFile
+	}
+	defer src.Close()
+
+	content, err := io.ReadAll(io.LimitReader(src, opts.MaxFileSizeMB*1024*1024+1))
+	if err != nil || len(content) == 0 {
+		return nil, http.StatusInternalServerError, constants.InvalidReadFile
+	}
+	if int64(len(content)) > opts.MaxFileSizeMB*1024*1024 {
+		return nil, http.StatusBadRequest, constants.FileSizeExceeded
+	}
+
+	if opts.Scanner != nil {
+		result, err := opts.Scanner.Scan(c.Request.Context(), bytes.NewReader(content))
+		if err != nil {
+			return nil, http.StatusServiceUnavailable, constants.FailedScanFile
+		}
+		if !result.Clean {
+			if err := quarantineFile(c.Request.Context(), minioClient, auth, file, content, result.Signature, opts); err != nil {
+				return nil, http.StatusServiceUnavailable, constants.FailedQuarantineFile
+			}
+			return nil, http.StatusUnprocessableEntity, constants.FileInfected
+		}
+	}
+
+	contentType := http.DetectContentType(content)
+	if len(opts.AllowedTypes) > 0 && !slices.Contains(opts.AllowedTypes, contentType) {
+		return nil, http.StatusBadRequest, constants.InvalidFileType
+	}
+
+	if !strings.HasPrefix(contentType, "image/") {
+		return &utils.SanitizedImage{Content: content, ContentType: contentType}, http.StatusOK, nil
+	}
+
+	sanitized, err := utils.SanitizeImage(content, opts.ImageConstraints())
+	if err != nil {
+		return nil, http.StatusBadRequest, ImageErrorMessage(err)
+	}
+
+	return sanitized, http.StatusOK, nil
+}
+
+func quarantineFile(ctx context.Context, minioClient *minio.Client, auth *model.Auth, file *multipart.FileHeader, content []byte, signature string, opts UploadOptions) error {
+	if opts.QuarantineBucket == "" {
+		return nil
+	}
+
+	now := time.Now()
+	formattedTime := now.Format("02-01-2006-15-04-05-000")
+	objectName := fmt.Sprintf("%s/%v-%s%s", opts.BucketName, auth.ID, formattedTime, filepath.Ext(file.Filename))
+
+	_, err := minioClient.PutObject(
+		ctx,
+		opts.QuarantineBucket,
+		objectName,
+		bytes.NewReader(content),
+		int64(len(content)),
+		minio.PutObjectOptions{
+			ContentType: "application/octet-stream",
+			UserMetadata: map[string]string{
+				"original_name": file.Filename,
+				"author_id":     auth.ID.String(),
+				"signature":     signature,
+				"target_bucket": opts.BucketName,
+				"created_at":    now.Format(time.RFC3339Nano),
+			},
+		},
+	)
+	if err != nil && opts.Log != nil {
+		opts.Log.WithError(err).WithFields(logrus.Fields{
+			"signature":  signature,
+			"object_key": objectName,
+			"bucket":     opts.QuarantineBucket,
+		}).Error("Failed to quarantine infected upload")
+	}
+	return err
+}
+
+func (opts UploadOptions) ImageConstraints() utils.ImageConstraints {
+	return utils.ImageConstraints{
+		MinWidth:       opts.MinWidth,
+		MinHeight:      opts.MinHeight,
+		MaxWidth:       opts.MaxWidth,
+		MaxHeight:      opts.MaxHeight,
+		MinAspectRatio: opts.MinAspectRatio,
+		MaxAspectRatio: opts.MaxAspectRatio,
+	}
+}
+
+func ImageErrorMessage(err error) model.Message {
+	switch {
+	case errors.Is(err, utils.ErrImageTooLarge):
+		return constants.ImageTooLarge
+	case errors.Is(err, utils.ErrImageTooManyFrames):
+		return constants.ImageTooManyFrames
+	case errors.Is(err, utils.ErrImageDimensions):
+		return constants.InvalidImageDimensions
+	case errors.Is(err, utils.ErrImageAspectRatio):
+		return constants.InvalidImageAspectRatio
+	default:
+		return constants.InvalidImageContent
+	}
+}
@@ -0,0 +1,207 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type PriceCampaignController struct {
+	Log                  *logrus.Logger
+	PriceCampaignUseCase *usecase.PriceCampaignUseCase
+}
+
+func NewPriceCampaignController(priceCampaignUseCase *usecase.PriceCampaignUseCase, log *logrus.Logger) *PriceCampaignController {
+	return &PriceCampaignController{
+		Log:                  log,
+		PriceCampaignUseCase: priceCampaignUseCase,
+	}
+}
+
+func (c *PriceCampaignController) GetPriceCampaigns(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.PriceCampaignUseCase.GetPriceCampaigns(ctx)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get price campaigns")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetPriceCampaigns, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetPriceCampaigns, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceCampaignController) GetPriceCampaignByID(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	campaignUUID, ok := parseIDParam(ctx, c.Log, "campaignID", constants.InvalidPriceCampaignID, constants.InvalidPriceCampaignIDFormat)
+	if !ok {
+		return
+	}
+
+	result, err := c.PriceCampaignUseCase.GetPriceCampaignByID(ctx, campaignUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get price campaign by ID")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetPriceCampaignByID, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetPriceCampaignByID, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceCampaignController) CreatePriceCampaign(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	request := new(model.PriceCampaignRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.PriceCampaignUseCase.CreatePriceCampaign(ctx, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to create price campaign")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedCreatePriceCampaign, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessCreatePriceCampaign, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceCampaignController) UpdatePriceCampaign(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	campaignUUID, ok := parseIDParam(ctx, c.Log, "campaignID", constants.InvalidPriceCampaignID, constants.InvalidPriceCampaignIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.PriceCampaignRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.PriceCampaignUseCase.UpdatePriceCampaign(ctx, campaignUUID, request)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update price campaign")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdatePriceCampaign, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdatePriceCampaign, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceCampaignController) DeletePriceCampaign(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	campaignUUID, ok := parseIDParam(ctx, c.Log, "campaignID", constants.InvalidPriceCampaignID, constants.InvalidPriceCampaignIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.PriceCampaignUseCase.DeletePriceCampaign(ctx, campaignUUID); err != nil {
+		c.Log.WithError(err).Error("Failed to delete price campaign")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedDeletePriceCampaign, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeletePriceCampaign, true)
+	ctx.JSON(res.StatusCode, res)
+}
@@ -0,0 +1,334 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type PriceListController struct {
+	Log              *logrus.Logger
+	PriceListUseCase *usecase.PriceListUseCase
+	ProductUseCase   *usecase.ProductUseCase
+}
+
+func NewPriceListController(priceListUseCase *usecase.PriceListUseCase, productUseCase *usecase.ProductUseCase, log *logrus.Logger) *PriceListController {
+	return &PriceListController{
+		Log:              log,
+		PriceListUseCase: priceListUseCase,
+		ProductUseCase:   productUseCase,
+	}
+}
+
+func (c *PriceListController) GetPriceLists(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.PriceListUseCase.GetPriceLists(ctx)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get price lists")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetPriceLists, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetPriceLists, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceListController) GetPriceListByID(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	priceListUUID, ok := parseIDParam(ctx, c.Log, "priceListID", constants.InvalidPriceListID, constants.InvalidPriceListIDFormat)
+	if !ok {
+		return
+	}
+
+	result, err := c.PriceListUseCase.GetPriceListByID(ctx, priceListUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get price list by ID")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetPriceListByID, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetPriceListByID, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceListController) CreatePriceList(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	request := new(model.PriceListRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.PriceListUseCase.CreatePriceList(ctx, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to create price list")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedCreatePriceList, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessCreatePriceList, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceListController) UpdatePriceList(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	priceListUUID, ok := parseIDParam(ctx, c.Log, "priceListID", constants.InvalidPriceListID, constants.InvalidPriceListIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.PriceListRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.PriceListUseCase.UpdatePriceList(ctx, priceListUUID, request)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update price list")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdatePriceList, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdatePriceList, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceListController) DeletePriceList(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	priceListUUID, ok := parseIDParam(ctx, c.Log, "priceListID", constants.InvalidPriceListID, constants.InvalidPriceListIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.PriceListUseCase.DeletePriceList(ctx, priceListUUID); err != nil {
+		c.Log.WithError(err).Error("Failed to delete price list")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedDeletePriceList, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeletePriceList, true)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceListController) GetProductPriceTiers(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	result, err := c.PriceListUseCase.GetProductPriceTiers(ctx, productUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get price tiers")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetPriceTiers, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetPriceTiers, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceListController) UpdateProductPriceTiers(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ProductPriceTiersRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.PriceListUseCase.UpdateProductPriceTiers(ctx, productUUID, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update price tiers")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdatePriceTiers, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdatePriceTiers, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *PriceListController) QuotePrice(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	quantity, err := strconv.Atoi(ctx.DefaultQuery("quantity", "1"))
+	if err != nil || quantity <= 0 {
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidQuoteQuantity, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	customerGroup := auth.CustomerGroup
+	if group := ctx.Query("customer_group"); group != "" {
+		var roles []string
+		if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+			c.Log.WithError(err).Error("Failed to decode roles")
+			res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+			ctx.AbortWithStatusJSON(res.StatusCode, res)
+			return
+		}
+
+		if !slices.Contains(roles, "admin") {
+			res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+			ctx.AbortWithStatusJSON(res.StatusCode, res)
+			return
+		}
+		customerGroup = strings.ToLower(group)
+	}
+
+	result, err := c.ProductUseCase.QuotePrice(ctx, productUUID, quantity, customerGroup)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to quote price")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedQuotePrice, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessQuotePrice, result)
+	ctx.JSON(res.StatusCode, res)
+}
@@ -6,19 +6,19 @@ import (
 	"golectro-product/internal/constants"
 	"golectro-product/internal/delivery/http/middleware"
 	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
 	"golectro-product/internal/usecase"
 	"golectro-product/internal/utils"
 	"io"
-	"math"
 	"net/http"
+	"net/url"
 	"path"
-	"strconv"
+	"strings"
 	"time"
 
 	"slices"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
@@ -29,37 +29,69 @@ type ProductController struct {
 	ImageUseCase         *usecase.ImageUseCase
 	MinioUseCase         *usecase.MinioUseCase
 	ElasticsearchUseCase *usecase.ElasticsearchUseCase
+	CategoryUseCase      *usecase.CategoryUseCase
+	CurrencyUseCase      *usecase.CurrencyUseCase
+	RenditionUseCase     *usecase.ImageRenditionUseCase
 	Viper                *viper.Viper
 }
 
-func NewProductController(userUseCase *usecase.ProductUseCase, minioUseCase *usecase.MinioUseCase, log *logrus.Logger, viper *viper.Viper, imageUseCase *usecase.ImageUseCase, elasticUseCase *usecase.ElasticsearchUseCase) *ProductController {
+func NewProductController(userUseCase *usecase.ProductUseCase, minioUseCase *usecase.MinioUseCase, log *logrus.Logger, viper *viper.Viper, imageUseCase *usecase.ImageUseCase, elasticUseCase *usecase.ElasticsearchUseCase, categoryUseCase *usecase.CategoryUseCase, currencyUseCase *usecase.CurrencyUseCase, renditionUseCase *usecase.ImageRenditionUseCase) *ProductController {
 	return &ProductController{
 		Log:                  log,
 		ProductUseCase:       userUseCase,
 		ImageUseCase:         imageUseCase,
 		MinioUseCase:         minioUseCase,
 		ElasticsearchUseCase: elasticUseCase,
+		CategoryUseCase:      categoryUseCase,
+		CurrencyUseCase:      currencyUseCase,
+		RenditionUseCase:     renditionUseCase,
 		Viper:                viper,
 	}
 }
 
 func (c *ProductController) GetAllProducts(ctx *gin.Context) {
-	pageStr := ctx.DefaultQuery("page", "1")
-	limitStr := ctx.DefaultQuery("limit", "10")
+	c.listProducts(ctx, []string{model.ProductStatusPublished})
+}
+
+func (c *ProductController) AdminGetAllProducts(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
 
-	page, err := strconv.Atoi(pageStr)
-	if err != nil || page <= 0 {
-		page = 1
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
 	}
 
-	limit, err := strconv.Atoi(limitStr)
-	if err != nil || limit <= 0 {
-		limit = 10
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	var statuses []string
+	if status := ctx.Query("status"); status != "" {
+		for item := range strings.SplitSeq(status, ",") {
+			item = strings.TrimSpace(item)
+			if _, ok := model.ProductStatusTransitions[item]; !ok {
+				res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductStatus, nil)
+				ctx.AbortWithStatusJSON(res.StatusCode, res)
+				return
+			}
+			statuses = append(statuses, item)
+		}
 	}
 
+	c.listProducts(ctx, statuses)
+}
+
+func (c *ProductController) listProducts(ctx *gin.Context, statuses []string) {
+	page, limit := parsePagination(ctx)
 	offset := (page - 1) * limit
 
-	products, total, err := c.ProductUseCase.GetAllProducts(ctx, limit, offset)
+	products, total, err := c.ProductUseCase.GetAllProducts(ctx, limit, offset, statuses)
 	if err != nil {
 		c.Log.WithError(err).Error("Failed to get addresses")
 		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetProducts, err)
@@ -67,48 +99,80 @@ func (c *ProductController) GetAllProducts(ctx *gin.Context) {
 		return
 	}
 
-	totalPages := int(math.Ceil(float64(total) / float64(limit)))
-	pagination := model.PageMetadata{
-		CurrentPage: page,
-		PageSize:    limit,
-		TotalPage:   int64(totalPages),
-		TotalItem:   total,
-		HasNext:     page < totalPages,
-		HasPrevious: page > 1,
+	locale := utils.ResolveLocale(ctx)
+	for i := range products {
+		converter.LocalizeProduct(&products[i], locale)
 	}
 
-	res := utils.SuccessWithPaginationResponse(ctx, http.StatusOK, constants.SuccessGetProducts, products, pagination)
+	res := utils.SuccessWithPaginationResponse(ctx, http.StatusOK, constants.SuccessGetProducts, products, pageMetadata(page, limit, total))
 	ctx.JSON(res.StatusCode, res)
 }
 
 func (c *ProductController) GetProductByID(ctx *gin.Context) {
-	productID := ctx.Param("productID")
-	if productID == "" {
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductID, nil)
-		ctx.AbortWithStatusJSON(res.StatusCode, res)
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
 		return
 	}
 
-	productUUID, err := uuid.Parse(productID)
+	product, err := c.ProductUseCase.GetProductByID(ctx, productUUID, utils.ResolveLocale(ctx))
 	if err != nil {
-		c.Log.WithError(err).Error("Invalid product ID format")
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductIDFormat, err)
+		c.Log.WithError(err).Error("Failed to get product by ID")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetProductByID, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
 
-	product, err := c.ProductUseCase.GetProductByID(ctx, productUUID)
-	if err != nil {
-		c.Log.WithError(err).Error("Failed to get product by ID")
-		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetProductByID, err)
+	if err := c.CurrencyUseCase.ApplyProductPricing(ctx, utils.ResolveCurrency(ctx), product); err != nil {
+		c.Log.WithError(err).Error("Failed to convert product prices")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedConvertCurrency, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
 
+	c.ImageUseCase.ApplyImageURLs(ctx, product)
+
 	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetProductByID, product)
 	ctx.JSON(res.StatusCode, res)
 }
 
+func (c *ProductController) GetProductBySlug(ctx *gin.Context) {
+	slug := ctx.Param("slug")
+	if slug == "" {
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductSlug, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	product, redirectSlug, err := c.ProductUseCase.GetProductBySlug(ctx, slug, utils.ResolveLocale(ctx))
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get product by slug")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetProductBySlug, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	if redirectSlug != "" {
+		location := strings.TrimSuffix(ctx.Request.URL.Path, slug) + url.PathEscape(redirectSlug)
+		if ctx.Request.URL.RawQuery != "" {
+			location += "?" + ctx.Request.URL.RawQuery
+		}
+		ctx.Redirect(http.StatusMovedPermanently, location)
+		return
+	}
+
+	if err := c.CurrencyUseCase.ApplyProductPricing(ctx, utils.ResolveCurrency(ctx), product); err != nil {
+		c.Log.WithError(err).Error("Failed to convert product prices")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedConvertCurrency, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	c.ImageUseCase.ApplyImageURLs(ctx, product)
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetProductBySlug, product)
+	ctx.JSON(res.StatusCode, res)
+}
+
 func (c *ProductController) SearchProducts(ctx *gin.Context) {
 	request := new(model.SearchProductsRequest)
 	if err := ctx.ShouldBindQuery(request); err != nil {
@@ -118,7 +182,21 @@ func (c *ProductController) SearchProducts(ctx *gin.Context) {
 		return
 	}
 
-	query := utils.BuildElasticQuery(ctx.Request.URL.Query())
+	locale := utils.ResolveLocale(ctx)
+	params := ctx.Request.URL.Query()
+	params.Set("lang", locale)
+	if category := params.Get("category"); category != "" {
+		categories, err := c.CategoryUseCase.ExpandCategorySlugs(ctx, strings.Split(category, ","))
+		if err != nil {
+			c.Log.WithError(err).Error("Failed to expand category filter")
+			res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedSearchProducts, err)
+			ctx.AbortWithStatusJSON(res.StatusCode, res)
+			return
+		}
+		params.Set("category", strings.Join(categories, ","))
+	}
+
+	query := utils.BuildElasticQuery(params)
 
 	products, total, err := c.ElasticsearchUseCase.SearchProducts(query)
 	if err != nil {
@@ -128,6 +206,19 @@ func (c *ProductController) SearchProducts(ctx *gin.Context) {
 		return
 	}
 
+	for _, product := range products {
+		converter.LocalizeProductDocument(product, locale)
+	}
+
+	if err := c.CurrencyUseCase.ApplyDocumentPricing(ctx, utils.ResolveCurrency(ctx), products); err != nil {
+		c.Log.WithError(err).Error("Failed to convert product prices")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedConvertCurrency, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	c.ImageUseCase.ApplyDocumentImageURLs(ctx, products)
+
 	if request.Limit == nil {
 		defaultLimit := 10
 		request.Limit = &defaultLimit
@@ -137,17 +228,7 @@ func (c *ProductController) SearchProducts(ctx *gin.Context) {
 		request.Page = &defaultPage
 	}
 
-	totalPages := int(math.Ceil(float64(total) / float64(*request.Limit)))
-	pagination := model.PageMetadata{
-		CurrentPage: *request.Page,
-		PageSize:    *request.Limit,
-		TotalPage:   int64(totalPages),
-		TotalItem:   total,
-		HasNext:     *request.Page < totalPages,
-		HasPrevious: *request.Page > 1,
-	}
-
-	res := utils.SuccessWithPaginationResponse(ctx, http.StatusOK, constants.SuccessSearchProducts, products, pagination)
+	res := utils.SuccessWithPaginationResponse(ctx, http.StatusOK, constants.SuccessSearchProducts, products, pageMetadata(*request.Page, *request.Limit, total))
 	ctx.JSON(res.StatusCode, res)
 }
 
@@ -207,22 +288,55 @@ func (c *ProductController) UpdateProduct(ctx *gin.Context) {
 		return
 	}
 
-	productID := ctx.Param("productID")
-	if productID == "" {
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductID, nil)
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.UpdateProductRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
 
-	productUUID, err := uuid.Parse(productID)
+	result, err := c.ProductUseCase.UpdateProduct(ctx, productUUID, request, auth.ID)
 	if err != nil {
-		c.Log.WithError(err).Error("Invalid product ID format")
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductIDFormat, err)
+		c.Log.WithError(err).Error("Failed to update product")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedUpdateProduct, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
 
-	request := new(model.UpdateProductRequest)
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateProduct, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductController) UpdateProductStatus(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ProductStatusRequest)
 	if err := ctx.ShouldBindJSON(request); err != nil {
 		c.Log.WithError(err).Error("Failed to bind request")
 		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
@@ -230,36 +344,64 @@ func (c *ProductController) UpdateProduct(ctx *gin.Context) {
 		return
 	}
 
-	result, err := c.ProductUseCase.UpdateProduct(ctx, productUUID, request)
+	result, err := c.ProductUseCase.UpdateProductStatus(ctx, productUUID, request, auth.ID)
 	if err != nil {
-		c.Log.WithError(err).Error("Failed to update product")
-		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedUpdateProduct, err)
+		c.Log.WithError(err).Error("Failed to update product status")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdateProductStatus, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
 
-	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateProduct, result)
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateProductStatus, result)
 	ctx.JSON(res.StatusCode, res)
 }
 
-func (c *ProductController) UploadProductImages(ctx *gin.Context) {
+func (c *ProductController) UpdateProductSchedule(ctx *gin.Context) {
 	auth := middleware.GetUser(ctx)
 
-	productID := ctx.Param("productID")
-	if productID == "" {
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductID, nil)
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
 
-	productUUID, err := uuid.Parse(productID)
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ProductScheduleRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ProductUseCase.UpdateProductSchedule(ctx, productUUID, request, auth.ID)
 	if err != nil {
-		c.Log.WithError(err).Error("Invalid product ID format")
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductIDFormat, err)
+		c.Log.WithError(err).Error("Failed to update product schedule")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdateProductSchedule, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
 
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateProductSchedule, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductController) GetProductStatusTransitions(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
 	var roles []string
 	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
 		c.Log.WithError(err).Error("Failed to decode roles")
@@ -275,6 +417,42 @@ func (c *ProductController) UploadProductImages(ctx *gin.Context) {
 		return
 	}
 
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	result, err := c.ProductUseCase.GetProductStatusTransitions(ctx, productUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get product status transitions")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetProductStatusTransitions, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetProductStatusTransitions, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductController) GetProductPriceHistory(ctx *gin.Context) {
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	result, err := c.ProductUseCase.GetProductPriceHistory(ctx, productUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get product price history")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetProductPriceHistory, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetProductPriceHistory, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductController) UploadProductImages(ctx *gin.Context) {
 	uploadedFilesAny, exists := ctx.Get("uploadedFiles")
 	if !exists {
 		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.NoFilesUploaded, nil)
@@ -283,10 +461,35 @@ func (c *ProductController) UploadProductImages(ctx *gin.Context) {
 	}
 
 	uploadedFiles := uploadedFilesAny.([]map[string]any)
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		c.discardUploadedFiles(ctx, uploadedFiles)
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		c.discardUploadedFiles(ctx, uploadedFiles)
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		c.discardUploadedFiles(ctx, uploadedFiles)
+		return
+	}
 
 	result, err := c.ProductUseCase.UploadProductImages(ctx, productUUID, uploadedFiles)
 	if err != nil {
 		c.Log.WithError(err).Error("Failed to upload product images")
+		c.discardUploadedFiles(ctx, uploadedFiles)
 		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
@@ -296,19 +499,22 @@ func (c *ProductController) UploadProductImages(ctx *gin.Context) {
 	ctx.JSON(res.StatusCode, res)
 }
 
-func (c *ProductController) GetProductImageURL(ctx *gin.Context) {
-	imageID := ctx.Param("imageID")
-	if imageID == "" {
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductID, nil)
-		ctx.AbortWithStatusJSON(res.StatusCode, res)
-		return
+func (c *ProductController) discardUploadedFiles(ctx *gin.Context, files []map[string]any) {
+	bucket := c.Viper.GetString("MINIO_BUCKET_PRODUCT")
+	for _, file := range files {
+		fileName, ok := file["file_name"].(string)
+		if !ok || fileName == "" {
+			continue
+		}
+		if err := c.MinioUseCase.Delete(ctx, bucket, fileName); err != nil {
+			c.Log.WithError(err).Warnf("Failed to discard uploaded file %s", fileName)
+		}
 	}
+}
 
-	imageUUID, err := uuid.Parse(imageID)
-	if err != nil {
-		c.Log.WithError(err).Error("Invalid image ID format")
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductIDFormat, err)
-		ctx.AbortWithStatusJSON(res.StatusCode, res)
+func (c *ProductController) GetProductImageURL(ctx *gin.Context) {
+	imageUUID, ok := parseIDParam(ctx, c.Log, "imageID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
 		return
 	}
 	result, err := c.ImageUseCase.GetImageByID(ctx, imageUUID)
@@ -347,18 +553,8 @@ func (c *ProductController) GetProductImageURL(ctx *gin.Context) {
 }
 
 func (c *ProductController) GetObjectImage(ctx *gin.Context) {
-	imageID := ctx.Param("imageID")
-	if imageID == "" {
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductID, nil)
-		ctx.AbortWithStatusJSON(res.StatusCode, res)
-		return
-	}
-
-	imageUUID, err := uuid.Parse(imageID)
-	if err != nil {
-		c.Log.WithError(err).Error("Invalid image ID format")
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductIDFormat, err)
-		ctx.AbortWithStatusJSON(res.StatusCode, res)
+	imageUUID, ok := parseIDParam(ctx, c.Log, "imageID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
 		return
 	}
 
@@ -376,26 +572,55 @@ func (c *ProductController) GetObjectImage(ctx *gin.Context) {
 		return
 	}
 
-	object, err := c.MinioUseCase.GetObject(ctx, c.Viper.GetString("MINIO_BUCKET_PRODUCT"), result.ImageObject)
+	request := new(model.ImagePreviewRequest)
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind image preview request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidImagePreviewRequest, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	rendition, err := c.RenditionUseCase.GetRendition(ctx, result.ImageObject, request)
 	if err != nil {
-		c.Log.WithError(err).Error("Failed to get object from Minio")
-		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetPresignedURL, err)
+		c.Log.WithError(err).Error("Failed to get image rendition")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGenerateRendition, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
-	defer object.Close()
 
-	info, err := object.Stat()
+	if rendition == nil {
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.ImageNotFound, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	etag := fmt.Sprintf("\"%s\"", rendition.ETag)
+	ctx.Header("Cache-Control", fmt.Sprintf("public, max-age=%d, immutable", model.ImageCacheMaxAge))
+	ctx.Header("ETag", etag)
+	ctx.Header("Last-Modified", rendition.LastModified.UTC().Format(http.TimeFormat))
+
+	if match := ctx.GetHeader("If-None-Match"); match != "" {
+		if match == "*" || slices.Contains(strings.Split(strings.ReplaceAll(match, " ", ""), ","), etag) {
+			ctx.Status(http.StatusNotModified)
+			return
+		}
+	} else if since, err := http.ParseTime(ctx.GetHeader("If-Modified-Since")); err == nil && !rendition.LastModified.Truncate(time.Second).After(since) {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+
+	object, err := c.MinioUseCase.GetObject(ctx, c.Viper.GetString("MINIO_BUCKET_PRODUCT"), rendition.ObjectKey)
 	if err != nil {
-		c.Log.WithError(err).Error("Failed to get object info from Minio")
+		c.Log.WithError(err).Error("Failed to get object from Minio")
 		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetPresignedURL, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
+	defer object.Close()
 
-	ctx.Header("Content-Type", info.ContentType)
-	ctx.Header("Content-Length", fmt.Sprintf("%d", info.Size))
-	ctx.Header("Content-Disposition", fmt.Sprintf("inline; filename=\"%s\"", path.Base(result.ImageObject)))
+	ctx.Header("Content-Type", rendition.ContentType)
+	ctx.Header("Content-Length", fmt.Sprintf("%d", rendition.Size))
+	ctx.Header("Content-Disposition", fmt.Sprintf("inline; filename=\"%s\"", path.Base(rendition.ObjectKey)))
 
 	_, err = io.Copy(ctx.Writer, object)
 	if err != nil {
@@ -423,18 +648,8 @@ func (c *ProductController) DeleteProduct(ctx *gin.Context) {
 		return
 	}
 
-	productID := ctx.Param("productID")
-	if productID == "" {
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductID, nil)
-		ctx.AbortWithStatusJSON(res.StatusCode, res)
-		return
-	}
-
-	productUUID, err := uuid.Parse(productID)
-	if err != nil {
-		c.Log.WithError(err).Error("Invalid product ID format")
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductIDFormat, err)
-		ctx.AbortWithStatusJSON(res.StatusCode, res)
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
 		return
 	}
 
@@ -446,7 +661,7 @@ func (c *ProductController) DeleteProduct(ctx *gin.Context) {
 		return
 	}
 
-	err = c.ProductUseCase.DeleteProduct(ctx, product)
+	err = c.ProductUseCase.DeleteProduct(ctx, product, auth.ID)
 	if err != nil {
 		c.Log.WithError(err).Error("Failed to delete product")
 		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedDeleteProduct, err)
@@ -458,7 +673,7 @@ func (c *ProductController) DeleteProduct(ctx *gin.Context) {
 	ctx.JSON(res.StatusCode, res)
 }
 
-func (c *ProductController) DeleteProductImage(ctx *gin.Context) {
+func (c *ProductController) RestoreProduct(ctx *gin.Context) {
 	auth := middleware.GetUser(ctx)
 
 	var roles []string
@@ -476,21 +691,46 @@ func (c *ProductController) DeleteProductImage(ctx *gin.Context) {
 		return
 	}
 
-	imageID := ctx.Param("imageID")
-	if imageID == "" {
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductID, nil)
-		ctx.AbortWithStatusJSON(res.StatusCode, res)
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
 		return
 	}
 
-	imageUUID, err := uuid.Parse(imageID)
+	result, err := c.ProductUseCase.RestoreProduct(ctx, productUUID, auth.ID)
 	if err != nil {
-		c.Log.WithError(err).Error("Invalid image ID format")
-		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductIDFormat, err)
+		c.Log.WithError(err).Error("Failed to restore product")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedRestoreProduct, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
 
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessRestoreProduct, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductController) DeleteProductImage(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	imageUUID, ok := parseIDParam(ctx, c.Log, "imageID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
 	image, err := c.ImageUseCase.GetImageByID(ctx, imageUUID)
 	if err != nil {
 		c.Log.WithError(err).Error("Failed to get image by ID")
@@ -513,14 +753,216 @@ func (c *ProductController) DeleteProductImage(ctx *gin.Context) {
 		return
 	}
 
-	err = c.MinioUseCase.Delete(ctx, c.Viper.GetString("MINIO_BUCKET_PRODUCT"), image.ImageObject)
+	if err := c.MinioUseCase.Delete(ctx, c.Viper.GetString("MINIO_BUCKET_PRODUCT"), image.ImageObject); err != nil {
+		c.Log.WithError(err).Warn("Failed to delete image from Minio, leaving it for image garbage collection")
+	}
+
+	if err := c.RenditionUseCase.DeleteRenditions(ctx, image.ImageObject); err != nil {
+		c.Log.WithError(err).Warn("Failed to delete image renditions from Minio")
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeleteProductImage, true)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductController) UpdateProductImage(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	imageID, ok := parseIDParam(ctx, c.Log, "imageID", constants.InvalidImageID, constants.InvalidImageIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ProductImageRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ImageUseCase.UpdateImage(ctx, imageID, request, utils.ResolveLocale(ctx))
 	if err != nil {
-		c.Log.WithError(err).Error("Failed to delete image from Minio")
-		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedDeleteImageFromMinio, err)
+		c.Log.WithError(err).Error("Failed to update product image")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdateProductImage, err)
 		ctx.AbortWithStatusJSON(res.StatusCode, res)
 		return
 	}
 
-	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeleteProductImage, true)
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateProductImage, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductController) ReorderProductImages(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ImageOrderRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ImageUseCase.ReorderImages(ctx, productID, request, utils.ResolveLocale(ctx))
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to reorder product images")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedReorderProductImages, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessReorderProductImages, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductController) CreateImageUploadURLs(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ImageUploadURLRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ImageUseCase.CreateUploadURLs(ctx, productID, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to create image upload URLs")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedCreateImageUpload, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessCreateImageUploadURLs, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductController) ConfirmImageUploads(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ImageUploadConfirmRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ImageUseCase.ConfirmUploads(ctx, productID, request, utils.ResolveLocale(ctx))
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to confirm image uploads")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedConfirmImageUpload, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessConfirmImageUpload, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductController) GetDuplicateImages(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	page, limit := parsePagination(ctx)
+	result, total, err := c.ImageUseCase.GetDuplicateImages(ctx, limit, (page-1)*limit, utils.ResolveLocale(ctx))
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get duplicate images")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedGetDuplicateImages, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessWithPaginationResponse(ctx, http.StatusOK, constants.SuccessGetDuplicateImages, result, pageMetadata(page, limit, total))
 	ctx.JSON(res.StatusCode, res)
 }
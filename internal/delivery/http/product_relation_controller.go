@@ -0,0 +1,179 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+type ProductRelationController struct {
+	Log                    *logrus.Logger
+	ProductRelationUseCase *usecase.ProductRelationUseCase
+	ProductUseCase         *usecase.ProductUseCase
+}
+
+func NewProductRelationController(productRelationUseCase *usecase.ProductRelationUseCase, productUseCase *usecase.ProductUseCase, log *logrus.Logger) *ProductRelationController {
+	return &ProductRelationController{
+		Log:                    log,
+		ProductRelationUseCase: productRelationUseCase,
+		ProductUseCase:         productUseCase,
+	}
+}
+
+func (c *ProductRelationController) GetRelatedProducts(ctx *gin.Context) {
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	var types []string
+	if relationType := ctx.Query("type"); relationType != "" {
+		for item := range strings.SplitSeq(relationType, ",") {
+			types = append(types, strings.TrimSpace(item))
+		}
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil || limit < 0 {
+		limit = 0
+	}
+
+	result, err := c.ProductUseCase.GetRelatedProducts(ctx, []uuid.UUID{productUUID}, types, limit, utils.ResolveLocale(ctx))
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get related products")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedGetRelatedProducts, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetRelatedProducts, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductRelationController) GetProductRelations(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	result, err := c.ProductRelationUseCase.GetProductRelations(ctx, productUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get product relations")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedGetProductRelations, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetProductRelations, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductRelationController) CreateProductRelation(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ProductRelationRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ProductRelationUseCase.CreateProductRelation(ctx, productUUID, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to create product relation")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedCreateProductRelation, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessCreateProductRelation, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductRelationController) DeleteProductRelation(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	relationUUID, ok := parseIDParam(ctx, c.Log, "relationID", constants.InvalidProductRelationID, constants.InvalidProductRelationIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.ProductRelationUseCase.DeleteProductRelation(ctx, productUUID, relationUUID); err != nil {
+		c.Log.WithError(err).Error("Failed to delete product relation")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedDeleteProductRelation, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeleteProductRelation, true)
+	ctx.JSON(res.StatusCode, res)
+}
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type ProductRevisionController struct {
+	Log                    *logrus.Logger
+	ProductRevisionUseCase *usecase.ProductRevisionUseCase
+}
+
+func NewProductRevisionController(productRevisionUseCase *usecase.ProductRevisionUseCase, log *logrus.Logger) *ProductRevisionController {
+	return &ProductRevisionController{
+		Log:                    log,
+		ProductRevisionUseCase: productRevisionUseCase,
+	}
+}
+
+func (c *ProductRevisionController) GetProductRevisions(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	result, err := c.ProductRevisionUseCase.GetProductRevisions(ctx, productUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get product revisions")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetProductRevisions, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetProductRevisions, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductRevisionController) DiffProductRevisions(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	from, err := strconv.Atoi(ctx.Query("from"))
+	if err != nil || from < 1 {
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductRevision, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	to, err := strconv.Atoi(ctx.Query("to"))
+	if err != nil || to < 1 {
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductRevision, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ProductRevisionUseCase.DiffProductRevisions(ctx, productUUID, from, to)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get product revision diff")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetProductRevisionDiff, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetProductRevisionDiff, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ProductRevisionController) RollbackProductRevision(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	revision, err := strconv.Atoi(ctx.Param("rev"))
+	if err != nil || revision < 1 {
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidProductRevision, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ProductRevisionUseCase.RollbackProductRevision(ctx, productUUID, revision, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to roll back product revision")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedRollbackProductRevision, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessRollbackProductRevision, result)
+	ctx.JSON(res.StatusCode, res)
+}
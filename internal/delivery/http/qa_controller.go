@@ -0,0 +1,437 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type QAController struct {
+	Log       *logrus.Logger
+	QAUseCase *usecase.QAUseCase
+}
+
+func NewQAController(qaUseCase *usecase.QAUseCase, log *logrus.Logger) *QAController {
+	return &QAController{
+		Log:       log,
+		QAUseCase: qaUseCase,
+	}
+}
+
+func (c *QAController) GetProductQuestions(ctx *gin.Context) {
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	page, limit := parsePagination(ctx)
+
+	questions, total, err := c.QAUseCase.GetProductQuestions(ctx, productUUID, ctx.DefaultQuery("sort", model.QuestionSortRecent), limit, (page-1)*limit)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get product questions")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedGetQuestions, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessWithPaginationResponse(ctx, http.StatusOK, constants.SuccessGetQuestions, questions, pageMetadata(page, limit, total))
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) GetProductQuestion(ctx *gin.Context) {
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	questionUUID, ok := parseIDParam(ctx, c.Log, "questionID", constants.InvalidQuestionID, constants.InvalidQuestionIDFormat)
+	if !ok {
+		return
+	}
+
+	result, err := c.QAUseCase.GetProductQuestion(ctx, productUUID, questionUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get product question")
+		res := utils.FailedResponse(ctx, http.StatusNotFound, constants.FailedGetQuestionByID, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetQuestionByID, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) CreateQuestion(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.QuestionRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.QAUseCase.CreateQuestion(ctx, productUUID, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to create question")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedCreateQuestion, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessCreateQuestion, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) DeleteQuestion(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	questionUUID, ok := parseIDParam(ctx, c.Log, "questionID", constants.InvalidQuestionID, constants.InvalidQuestionIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.QAUseCase.DeleteQuestion(ctx, productUUID, questionUUID, auth.ID, slices.Contains(roles, "admin")); err != nil {
+		c.Log.WithError(err).Error("Failed to delete question")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedDeleteQuestion, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeleteQuestion, true)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) CreateAnswer(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	questionUUID, ok := parseIDParam(ctx, c.Log, "questionID", constants.InvalidQuestionID, constants.InvalidQuestionIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.AnswerRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.QAUseCase.CreateAnswer(ctx, productUUID, questionUUID, request, auth.ID, slices.Contains(roles, "admin"))
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to create answer")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedCreateAnswer, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessCreateAnswer, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) DeleteAnswer(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	questionUUID, ok := parseIDParam(ctx, c.Log, "questionID", constants.InvalidQuestionID, constants.InvalidQuestionIDFormat)
+	if !ok {
+		return
+	}
+
+	answerUUID, ok := parseIDParam(ctx, c.Log, "answerID", constants.InvalidAnswerID, constants.InvalidAnswerIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.QAUseCase.DeleteAnswer(ctx, productUUID, questionUUID, answerUUID, auth.ID, slices.Contains(roles, "admin")); err != nil {
+		c.Log.WithError(err).Error("Failed to delete answer")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedDeleteAnswer, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeleteAnswer, true)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) UpvoteQuestion(ctx *gin.Context) {
+	c.vote(ctx, model.QATargetQuestion, true)
+}
+
+func (c *QAController) RemoveQuestionUpvote(ctx *gin.Context) {
+	c.vote(ctx, model.QATargetQuestion, false)
+}
+
+func (c *QAController) UpvoteAnswer(ctx *gin.Context) {
+	c.vote(ctx, model.QATargetAnswer, true)
+}
+
+func (c *QAController) RemoveAnswerUpvote(ctx *gin.Context) {
+	c.vote(ctx, model.QATargetAnswer, false)
+}
+
+func (c *QAController) vote(ctx *gin.Context, targetType string, upvote bool) {
+	auth := middleware.GetUser(ctx)
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	targetUUID, ok := parseIDParam(ctx, c.Log, "questionID", constants.InvalidQuestionID, constants.InvalidQuestionIDFormat)
+	if !ok {
+		return
+	}
+
+	if targetType == model.QATargetAnswer {
+		if targetUUID, ok = parseIDParam(ctx, c.Log, "answerID", constants.InvalidAnswerID, constants.InvalidAnswerIDFormat); !ok {
+			return
+		}
+	}
+
+	if upvote {
+		if err := c.QAUseCase.Upvote(ctx, productUUID, targetType, targetUUID, auth.ID); err != nil {
+			c.Log.WithError(err).Error("Failed to upvote")
+			res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpvote, err)
+			ctx.AbortWithStatusJSON(res.StatusCode, res)
+			return
+		}
+
+		res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpvote, true)
+		ctx.JSON(res.StatusCode, res)
+		return
+	}
+
+	if err := c.QAUseCase.RemoveUpvote(ctx, productUUID, targetType, targetUUID, auth.ID); err != nil {
+		c.Log.WithError(err).Error("Failed to remove upvote")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedRemoveUpvote, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessRemoveUpvote, true)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) GetQuestions(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	status, ok := c.moderationStatus(ctx)
+	if !ok {
+		return
+	}
+
+	page, limit := parsePagination(ctx)
+
+	questions, total, err := c.QAUseCase.GetQuestions(ctx, status, limit, (page-1)*limit)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get questions")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetQuestions, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessWithPaginationResponse(ctx, http.StatusOK, constants.SuccessGetQuestions, questions, pageMetadata(page, limit, total))
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) GetAnswers(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	status, ok := c.moderationStatus(ctx)
+	if !ok {
+		return
+	}
+
+	page, limit := parsePagination(ctx)
+
+	answers, total, err := c.QAUseCase.GetAnswers(ctx, status, limit, (page-1)*limit)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get answers")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetAnswers, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessWithPaginationResponse(ctx, http.StatusOK, constants.SuccessGetAnswers, answers, pageMetadata(page, limit, total))
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) ModerateQuestion(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	questionUUID, ok := parseIDParam(ctx, c.Log, "questionID", constants.InvalidQuestionID, constants.InvalidQuestionIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.QAModerationRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.QAUseCase.ModerateQuestion(ctx, questionUUID, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to moderate question")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedModerateQuestion, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessModerateQuestion, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) ModerateAnswer(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	answerUUID, ok := parseIDParam(ctx, c.Log, "answerID", constants.InvalidAnswerID, constants.InvalidAnswerIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.QAModerationRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.QAUseCase.ModerateAnswer(ctx, answerUUID, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to moderate answer")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedModerateAnswer, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessModerateAnswer, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *QAController) moderationStatus(ctx *gin.Context) (string, bool) {
+	status := ctx.Query("status")
+	if status != "" && !slices.Contains([]string{model.QAStatusPending, model.QAStatusApproved, model.QAStatusRejected}, status) {
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidQAStatus, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return "", false
+	}
+	return status, true
+}
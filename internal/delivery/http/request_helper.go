@@ -0,0 +1,58 @@
+package http
+
+import (
+	"golectro-product/internal/model"
+	"golectro-product/internal/utils"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func parseIDParam(ctx *gin.Context, log *logrus.Logger, name string, invalid, invalidFormat model.Message) (uuid.UUID, bool) {
+	value := ctx.Param(name)
+	if value == "" {
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, invalid, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		log.WithError(err).Errorf("Invalid %s format", name)
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, invalidFormat, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+func parsePagination(ctx *gin.Context) (int, int) {
+	page, err := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if err != nil || limit <= 0 {
+		limit = 10
+	}
+
+	return page, limit
+}
+
+func pageMetadata(page, limit int, total int64) model.PageMetadata {
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+	return model.PageMetadata{
+		CurrentPage: page,
+		PageSize:    limit,
+		TotalPage:   int64(totalPages),
+		TotalItem:   total,
+		HasNext:     page < totalPages,
+		HasPrevious: page > 1,
+	}
+}
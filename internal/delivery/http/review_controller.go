@@ -0,0 +1,310 @@
+package http
+
+import (
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
+	"golectro-product/internal/usecase"
+	"golectro-product/internal/utils"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type ReviewController struct {
+	Log           *logrus.Logger
+	ReviewUseCase *usecase.ReviewUseCase
+}
+
+func NewReviewController(reviewUseCase *usecase.ReviewUseCase, log *logrus.Logger) *ReviewController {
+	return &ReviewController{
+		Log:           log,
+		ReviewUseCase: reviewUseCase,
+	}
+}
+
+func (c *ReviewController) GetProductReviews(ctx *gin.Context) {
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	page, limit := parsePagination(ctx)
+
+	reviews, total, err := c.ReviewUseCase.GetProductReviews(ctx, productUUID, ctx.DefaultQuery("sort", model.ReviewSortRecent), limit, (page-1)*limit)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get product reviews")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedGetReviews, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessWithPaginationResponse(ctx, http.StatusOK, constants.SuccessGetReviews, reviews, pageMetadata(page, limit, total))
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ReviewController) GetRatingSummary(ctx *gin.Context) {
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	result, err := c.ReviewUseCase.GetRatingSummary(ctx, productUUID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get rating summary")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedGetRatingSummary, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessGetRatingSummary, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ReviewController) CreateReview(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ReviewRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ReviewUseCase.CreateReview(ctx, productUUID, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to create review")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedCreateReview, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessCreateReview, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ReviewController) UpdateReview(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	reviewUUID, ok := parseIDParam(ctx, c.Log, "reviewID", constants.InvalidReviewID, constants.InvalidReviewIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ReviewRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ReviewUseCase.UpdateReview(ctx, productUUID, reviewUUID, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to update review")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUpdateReview, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessUpdateReview, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ReviewController) DeleteReview(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	reviewUUID, ok := parseIDParam(ctx, c.Log, "reviewID", constants.InvalidReviewID, constants.InvalidReviewIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.ReviewUseCase.DeleteReview(ctx, productUUID, reviewUUID, auth.ID, slices.Contains(roles, "admin")); err != nil {
+		c.Log.WithError(err).Error("Failed to delete review")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedDeleteReview, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessDeleteReview, true)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ReviewController) UploadReviewPhotos(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	productUUID, ok := parseIDParam(ctx, c.Log, "productID", constants.InvalidProductID, constants.InvalidProductIDFormat)
+	if !ok {
+		return
+	}
+
+	reviewUUID, ok := parseIDParam(ctx, c.Log, "reviewID", constants.InvalidReviewID, constants.InvalidReviewIDFormat)
+	if !ok {
+		return
+	}
+
+	uploadedFilesAny, exists := ctx.Get("uploadedFiles")
+	if !exists {
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.NoFilesUploaded, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ReviewUseCase.UploadReviewPhotos(ctx, productUUID, reviewUUID, auth.ID, uploadedFilesAny.([]map[string]any))
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to upload review photos")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedUploadReviewPhotos, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusCreated, constants.SuccessUploadReviewPhotos, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ReviewController) GetReviews(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	status := ctx.Query("status")
+	if status != "" && !slices.Contains([]string{model.ReviewStatusPending, model.ReviewStatusApproved, model.ReviewStatusRejected}, status) {
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidReviewStatus, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	page, limit := parsePagination(ctx)
+
+	reviews, total, err := c.ReviewUseCase.GetReviews(ctx, status, limit, (page-1)*limit)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to get reviews")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.FailedGetReviews, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessWithPaginationResponse(ctx, http.StatusOK, constants.SuccessGetReviews, reviews, pageMetadata(page, limit, total))
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ReviewController) ModerateReview(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	var roles []string
+	if err := json.Unmarshal(auth.Roles, &roles); err != nil {
+		c.Log.WithError(err).Error("Failed to decode roles")
+		res := utils.FailedResponse(ctx, http.StatusInternalServerError, constants.InternalServerError, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	isAdmin := slices.Contains(roles, "admin")
+	if !isAdmin {
+		res := utils.FailedResponse(ctx, http.StatusForbidden, constants.AccessDenied, nil)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	reviewUUID, ok := parseIDParam(ctx, c.Log, "reviewID", constants.InvalidReviewID, constants.InvalidReviewIDFormat)
+	if !ok {
+		return
+	}
+
+	request := new(model.ReviewModerationRequest)
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		c.Log.WithError(err).Error("Failed to bind request")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.InvalidRequestData, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	result, err := c.ReviewUseCase.ModerateReview(ctx, reviewUUID, request, auth.ID)
+	if err != nil {
+		c.Log.WithError(err).Error("Failed to moderate review")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedModerateReview, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessModerateReview, result)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ReviewController) VoteHelpful(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	reviewUUID, ok := parseIDParam(ctx, c.Log, "reviewID", constants.InvalidReviewID, constants.InvalidReviewIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.ReviewUseCase.VoteHelpful(ctx, reviewUUID, auth.ID); err != nil {
+		c.Log.WithError(err).Error("Failed to vote review as helpful")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedVoteReview, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessVoteReview, true)
+	ctx.JSON(res.StatusCode, res)
+}
+
+func (c *ReviewController) RemoveHelpfulVote(ctx *gin.Context) {
+	auth := middleware.GetUser(ctx)
+
+	reviewUUID, ok := parseIDParam(ctx, c.Log, "reviewID", constants.InvalidReviewID, constants.InvalidReviewIDFormat)
+	if !ok {
+		return
+	}
+
+	if err := c.ReviewUseCase.RemoveHelpfulVote(ctx, reviewUUID, auth.ID); err != nil {
+		c.Log.WithError(err).Error("Failed to remove helpful vote")
+		res := utils.FailedResponse(ctx, http.StatusBadRequest, constants.FailedRemoveReviewVote, err)
+		ctx.AbortWithStatusJSON(res.StatusCode, res)
+		return
+	}
+
+	res := utils.SuccessResponse(ctx, http.StatusOK, constants.SuccessRemoveReviewVote, true)
+	ctx.JSON(res.StatusCode, res)
+}
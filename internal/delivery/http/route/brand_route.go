@@ -0,0 +1,27 @@
+package route
+
+import (
+	"golectro-product/internal/delivery/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/minio/minio-go/v7"
+)
+
+func (c *RouteConfig) RegisterBrandRoutes(rg *gin.RouterGroup, minioClient *minio.Client) {
+	brand := rg.Group("/brands")
+
+	brand.GET("/", c.BrandController.GetAllBrands)
+	brand.GET("/:brandID", c.BrandController.GetBrandByID)
+	brand.POST("/", c.AuthMiddleware, c.BrandController.CreateBrand)
+	brand.PUT("/:brandID", c.AuthMiddleware, c.BrandController.UpdateBrand)
+	brand.POST("/:brandID/logo", c.AuthMiddleware, middleware.SingleFileUpload(minioClient, middleware.UploadOptions{
+		FieldName:        "logo",
+		MaxFileSizeMB:    2,
+		BucketName:       c.Viper.GetString("MINIO_BUCKET_BRAND"),
+		AllowedTypes:     []string{"image/jpeg", "image/png"},
+		Scanner:          c.FileScanner,
+		QuarantineBucket: c.Viper.GetString("MINIO_BUCKET_QUARANTINE"),
+		Log:              c.Log,
+	}), c.BrandController.UploadBrandLogo)
+	brand.DELETE("/:brandID", c.AuthMiddleware, c.BrandController.DeleteBrand)
+}
@@ -0,0 +1,17 @@
+package route
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func (c *RouteConfig) RegisterCategoryRoutes(rg *gin.RouterGroup) {
+	category := rg.Group("/categories")
+
+	category.GET("/", c.CategoryController.GetCategoryTree)
+	category.GET("/:categoryID", c.CategoryController.GetCategoryByID)
+	category.POST("/", c.AuthMiddleware, c.CategoryController.CreateCategory)
+	category.PUT("/:categoryID", c.AuthMiddleware, c.CategoryController.UpdateCategory)
+	category.DELETE("/:categoryID", c.AuthMiddleware, c.CategoryController.DeleteCategory)
+	category.GET("/:categoryID/specs", c.CategoryController.GetCategorySpecs)
+	category.PUT("/:categoryID/specs", c.AuthMiddleware, c.CategoryController.ReplaceCategorySpecs)
+}
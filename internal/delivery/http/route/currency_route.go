@@ -0,0 +1,10 @@
+package route
+
+import "github.com/gin-gonic/gin"
+
+func (c *RouteConfig) RegisterCurrencyRoutes(rg *gin.RouterGroup) {
+	rate := rg.Group("/exchange-rates")
+
+	rate.GET("/", c.CurrencyController.GetExchangeRates)
+	rate.PUT("/", c.AuthMiddleware, c.CurrencyController.UpdateExchangeRates)
+}
@@ -0,0 +1,13 @@
+package route
+
+import "github.com/gin-gonic/gin"
+
+func (c *RouteConfig) RegisterFlashSaleRoutes(rg *gin.RouterGroup) {
+	sale := rg.Group("/flash-sales")
+
+	sale.GET("/", c.FlashSaleController.GetFlashSales)
+	sale.GET("/:saleID", c.FlashSaleController.GetFlashSaleByID)
+	sale.POST("/", c.AuthMiddleware, c.FlashSaleController.CreateFlashSale)
+	sale.PUT("/:saleID", c.AuthMiddleware, c.FlashSaleController.UpdateFlashSale)
+	sale.DELETE("/:saleID", c.AuthMiddleware, c.FlashSaleController.DeleteFlashSale)
+}
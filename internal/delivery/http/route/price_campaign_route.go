@@ -0,0 +1,13 @@
+package route
+
+import "github.com/gin-gonic/gin"
+
+func (c *RouteConfig) RegisterPriceCampaignRoutes(rg *gin.RouterGroup) {
+	campaign := rg.Group("/price-campaigns")
+
+	campaign.GET("/", c.AuthMiddleware, c.PriceCampaignController.GetPriceCampaigns)
+	campaign.GET("/:campaignID", c.AuthMiddleware, c.PriceCampaignController.GetPriceCampaignByID)
+	campaign.POST("/", c.AuthMiddleware, c.PriceCampaignController.CreatePriceCampaign)
+	campaign.PUT("/:campaignID", c.AuthMiddleware, c.PriceCampaignController.UpdatePriceCampaign)
+	campaign.DELETE("/:campaignID", c.AuthMiddleware, c.PriceCampaignController.DeletePriceCampaign)
+}
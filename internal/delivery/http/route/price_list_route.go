@@ -0,0 +1,13 @@
+package route
+
+import "github.com/gin-gonic/gin"
+
+func (c *RouteConfig) RegisterPriceListRoutes(rg *gin.RouterGroup) {
+	priceList := rg.Group("/price-lists")
+
+	priceList.GET("/", c.AuthMiddleware, c.PriceListController.GetPriceLists)
+	priceList.GET("/:priceListID", c.AuthMiddleware, c.PriceListController.GetPriceListByID)
+	priceList.POST("/", c.AuthMiddleware, c.PriceListController.CreatePriceList)
+	priceList.PUT("/:priceListID", c.AuthMiddleware, c.PriceListController.UpdatePriceList)
+	priceList.DELETE("/:priceListID", c.AuthMiddleware, c.PriceListController.DeletePriceList)
+}
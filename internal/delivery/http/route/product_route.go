@@ -2,6 +2,7 @@ package route
 
 import (
 	"golectro-product/internal/delivery/http/middleware"
+	"golectro-product/internal/model"
 
 	"github.com/gin-gonic/gin"
 	"github.com/minio/minio-go/v7"
@@ -11,19 +12,78 @@ func (c *RouteConfig) RegisterProductRoutes(rg *gin.RouterGroup, minioClient *mi
 	product := rg.Group("/products")
 
 	product.GET("/", c.ProductController.GetAllProducts)
+	product.GET("/admin", c.AuthMiddleware, c.ProductController.AdminGetAllProducts)
 	product.GET("/:productID", c.ProductController.GetProductByID)
 	product.GET("/search", c.ProductController.SearchProducts)
+	product.GET("/slug/:slug", c.ProductController.GetProductBySlug)
 	product.POST("/", c.ProductController.CreateProduct)
 	product.PUT("/:productID", c.AuthMiddleware, c.ProductController.UpdateProduct)
+	product.PUT("/:productID/status", c.AuthMiddleware, c.ProductController.UpdateProductStatus)
+	product.PUT("/:productID/schedule", c.AuthMiddleware, c.ProductController.UpdateProductSchedule)
+	product.GET("/:productID/status-history", c.AuthMiddleware, c.ProductController.GetProductStatusTransitions)
+	product.GET("/:productID/price-history", c.ProductController.GetProductPriceHistory)
+	product.GET("/:productID/price-tiers", c.AuthMiddleware, c.PriceListController.GetProductPriceTiers)
+	product.PUT("/:productID/price-tiers", c.AuthMiddleware, c.PriceListController.UpdateProductPriceTiers)
+	product.GET("/:productID/quote", c.AuthMiddleware, c.PriceListController.QuotePrice)
+	product.PUT("/:productID/components", c.AuthMiddleware, c.BundleController.UpdateBundleComponents)
+	product.GET("/:productID/related", c.ProductRelationController.GetRelatedProducts)
+	product.GET("/:productID/relations", c.AuthMiddleware, c.ProductRelationController.GetProductRelations)
+	product.POST("/:productID/relations", c.AuthMiddleware, c.ProductRelationController.CreateProductRelation)
+	product.DELETE("/:productID/relations/:relationID", c.AuthMiddleware, c.ProductRelationController.DeleteProductRelation)
+	product.GET("/:productID/reviews", c.ReviewController.GetProductReviews)
+	product.GET("/:productID/reviews/summary", c.ReviewController.GetRatingSummary)
+	product.POST("/:productID/reviews", c.AuthMiddleware, c.ReviewController.CreateReview)
+	product.PUT("/:productID/reviews/:reviewID", c.AuthMiddleware, c.ReviewController.UpdateReview)
+	product.DELETE("/:productID/reviews/:reviewID", c.AuthMiddleware, c.ReviewController.DeleteReview)
+	product.POST("/:productID/reviews/:reviewID/photos", c.AuthMiddleware, middleware.MultipleFileUpload(minioClient, middleware.UploadOptions{
+		FieldName:        "photos",
+		MaxFileSizeMB:    5,
+		MaxFiles:         model.ReviewMaxPhotos,
+		BucketName:       c.Viper.GetString("MINIO_BUCKET_REVIEW"),
+		AllowedTypes:     []string{"image/jpeg", "image/png"},
+		MaxWidth:         model.ProductImageMaxDimension,
+		MaxHeight:        model.ProductImageMaxDimension,
+		Scanner:          c.FileScanner,
+		QuarantineBucket: c.Viper.GetString("MINIO_BUCKET_QUARANTINE"),
+		Log:              c.Log,
+	}), c.ReviewController.UploadReviewPhotos)
+	product.GET("/:productID/questions", c.QAController.GetProductQuestions)
+	product.GET("/:productID/questions/:questionID", c.QAController.GetProductQuestion)
+	product.POST("/:productID/questions", c.AuthMiddleware, c.QAController.CreateQuestion)
+	product.DELETE("/:productID/questions/:questionID", c.AuthMiddleware, c.QAController.DeleteQuestion)
+	product.POST("/:productID/questions/:questionID/upvote", c.AuthMiddleware, c.QAController.UpvoteQuestion)
+	product.DELETE("/:productID/questions/:questionID/upvote", c.AuthMiddleware, c.QAController.RemoveQuestionUpvote)
+	product.POST("/:productID/questions/:questionID/answers", c.AuthMiddleware, c.QAController.CreateAnswer)
+	product.DELETE("/:productID/questions/:questionID/answers/:answerID", c.AuthMiddleware, c.QAController.DeleteAnswer)
+	product.POST("/:productID/questions/:questionID/answers/:answerID/upvote", c.AuthMiddleware, c.QAController.UpvoteAnswer)
+	product.DELETE("/:productID/questions/:questionID/answers/:answerID/upvote", c.AuthMiddleware, c.QAController.RemoveAnswerUpvote)
+	product.GET("/:productID/revisions", c.AuthMiddleware, c.ProductRevisionController.GetProductRevisions)
+	product.GET("/:productID/revisions/diff", c.AuthMiddleware, c.ProductRevisionController.DiffProductRevisions)
+	product.POST("/:productID/revisions/:rev/rollback", c.AuthMiddleware, c.ProductRevisionController.RollbackProductRevision)
 	product.POST("/:productID/images", c.AuthMiddleware, middleware.MultipleFileUpload(minioClient, middleware.UploadOptions{
-		FieldName:     "images",
-		MaxFileSizeMB: 5,
-		MaxFiles:      5,
-		BucketName:    c.Viper.GetString("MINIO_BUCKET_PRODUCT"),
-		AllowedTypes:  []string{"image/jpeg", "image/png", "image/gif"},
+		FieldName:        "images",
+		MaxFileSizeMB:    model.ImageUploadMaxFileSizeMB,
+		MaxFiles:         model.ImageUploadMaxFiles,
+		BucketName:       c.Viper.GetString("MINIO_BUCKET_PRODUCT"),
+		AllowedTypes:     model.ImageUploadAllowedTypes,
+		MinWidth:         model.ProductImageMinDimension,
+		MinHeight:        model.ProductImageMinDimension,
+		MaxWidth:         model.ProductImageMaxDimension,
+		MaxHeight:        model.ProductImageMaxDimension,
+		MinAspectRatio:   model.ProductImageMinAspectRatio,
+		MaxAspectRatio:   model.ProductImageMaxAspectRatio,
+		Scanner:          c.FileScanner,
+		QuarantineBucket: c.Viper.GetString("MINIO_BUCKET_QUARANTINE"),
+		Log:              c.Log,
 	}), c.ProductController.UploadProductImages)
+	product.POST("/:productID/images/upload-urls", c.AuthMiddleware, c.ProductController.CreateImageUploadURLs)
+	product.POST("/:productID/images/confirm", c.AuthMiddleware, c.ProductController.ConfirmImageUploads)
+	product.PUT("/:productID/images/order", c.AuthMiddleware, c.ProductController.ReorderProductImages)
+	product.GET("/images/duplicates", c.AuthMiddleware, c.ProductController.GetDuplicateImages)
 	product.GET("/image/:imageID/url", c.ProductController.GetProductImageURL)
+	product.PUT("/image/:imageID", c.AuthMiddleware, c.ProductController.UpdateProductImage)
 	product.GET("/image/:imageID/preview", c.ProductController.GetObjectImage)
 	product.DELETE("/:productID", c.AuthMiddleware, c.ProductController.DeleteProduct)
+	product.POST("/:productID/restore", c.AuthMiddleware, c.ProductController.RestoreProduct)
 	product.DELETE("/image/:imageID", c.AuthMiddleware, c.ProductController.DeleteProductImage)
 }
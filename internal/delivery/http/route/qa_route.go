@@ -0,0 +1,15 @@
+package route
+
+import "github.com/gin-gonic/gin"
+
+func (c *RouteConfig) RegisterQARoutes(rg *gin.RouterGroup) {
+	question := rg.Group("/questions")
+
+	question.GET("/", c.AuthMiddleware, c.QAController.GetQuestions)
+	question.PUT("/:questionID/moderation", c.AuthMiddleware, c.QAController.ModerateQuestion)
+
+	answer := rg.Group("/answers")
+
+	answer.GET("/", c.AuthMiddleware, c.QAController.GetAnswers)
+	answer.PUT("/:answerID/moderation", c.AuthMiddleware, c.QAController.ModerateAnswer)
+}
@@ -0,0 +1,12 @@
+package route
+
+import "github.com/gin-gonic/gin"
+
+func (c *RouteConfig) RegisterReviewRoutes(rg *gin.RouterGroup) {
+	review := rg.Group("/reviews")
+
+	review.GET("/", c.AuthMiddleware, c.ReviewController.GetReviews)
+	review.PUT("/:reviewID/moderation", c.AuthMiddleware, c.ReviewController.ModerateReview)
+	review.POST("/:reviewID/helpful", c.AuthMiddleware, c.ReviewController.VoteHelpful)
+	review.DELETE("/:reviewID/helpful", c.AuthMiddleware, c.ReviewController.RemoveHelpfulVote)
+}
@@ -2,19 +2,34 @@ package route
 
 import (
 	"golectro-product/internal/delivery/http"
+	"golectro-product/internal/repository"
 
 	"github.com/gin-gonic/gin"
 	"github.com/minio/minio-go/v7"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
 
 type RouteConfig struct {
-	App               *gin.Engine
-	Minio             *minio.Client
-	AuthMiddleware    gin.HandlerFunc
-	Viper             *viper.Viper
-	ProductController *http.ProductController
-	SwaggerController *http.SwaggerController
+	App                       *gin.Engine
+	Minio                     *minio.Client
+	AuthMiddleware            gin.HandlerFunc
+	FileScanner               repository.FileScanner
+	Viper                     *viper.Viper
+	Log                       *logrus.Logger
+	ProductController         *http.ProductController
+	CategoryController        *http.CategoryController
+	BrandController           *http.BrandController
+	ProductRevisionController *http.ProductRevisionController
+	PriceCampaignController   *http.PriceCampaignController
+	FlashSaleController       *http.FlashSaleController
+	CurrencyController        *http.CurrencyController
+	PriceListController       *http.PriceListController
+	BundleController          *http.BundleController
+	ProductRelationController *http.ProductRelationController
+	ReviewController          *http.ReviewController
+	QAController              *http.QAController
+	SwaggerController         *http.SwaggerController
 }
 
 func (c *RouteConfig) Setup() {
@@ -23,4 +38,12 @@ func (c *RouteConfig) Setup() {
 	c.RegisterCommonRoutes(c.App)
 	c.RegisterSwaggerRoutes(api)
 	c.RegisterProductRoutes(api, c.Minio)
+	c.RegisterCategoryRoutes(api)
+	c.RegisterBrandRoutes(api, c.Minio)
+	c.RegisterPriceCampaignRoutes(api)
+	c.RegisterFlashSaleRoutes(api)
+	c.RegisterCurrencyRoutes(api)
+	c.RegisterPriceListRoutes(api)
+	c.RegisterReviewRoutes(api)
+	c.RegisterQARoutes(api)
 }
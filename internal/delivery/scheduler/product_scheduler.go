@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"context"
+	"golectro-product/internal/usecase"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+var renewLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+type ProductScheduler struct {
+	ProductUseCase       *usecase.ProductUseCase
+	PriceCampaignUseCase *usecase.PriceCampaignUseCase
+	Redis                *redis.Client
+	Log                  *logrus.Logger
+	Interval             time.Duration
+	LockKey              string
+	InstanceID           string
+}
+
+func NewProductScheduler(productUseCase *usecase.ProductUseCase, priceCampaignUseCase *usecase.PriceCampaignUseCase, redis *redis.Client, log *logrus.Logger, viper *viper.Viper) *ProductScheduler {
+	interval := viper.GetDuration("SCHEDULER_INTERVAL")
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	return &ProductScheduler{
+		ProductUseCase:       productUseCase,
+		PriceCampaignUseCase: priceCampaignUseCase,
+		Redis:                redis,
+		Log:                  log,
+		Interval:             interval,
+		LockKey:              "product_scheduler:leader",
+		InstanceID:           uuid.NewString(),
+	}
+}
+
+func (s *ProductScheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	s.Log.Infof("Product scheduler started with interval %s", s.Interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.tick(ctx)
+		}
+	}
+}
+
+func (s *ProductScheduler) tick(ctx context.Context) {
+	leader, err := s.acquireLeadership(ctx)
+	if err != nil {
+		s.Log.WithError(err).Warn("Failed to acquire product scheduler lock")
+		return
+	}
+
+	if !leader {
+		return
+	}
+
+	now := time.Now()
+
+	applied, err := s.ProductUseCase.RunScheduledTransitions(ctx, now)
+	if err != nil {
+		s.Log.WithError(err).Error("Failed to run scheduled product transitions")
+	} else if applied > 0 {
+		s.Log.Infof("Applied %d scheduled product transitions", applied)
+	}
+
+	campaigns, err := s.PriceCampaignUseCase.RunCampaignTransitions(ctx, now)
+	if err != nil {
+		s.Log.WithError(err).Error("Failed to run price campaign transitions")
+	} else if campaigns > 0 {
+		s.Log.Infof("Applied %d price campaign transitions", campaigns)
+	}
+}
+
+func (s *ProductScheduler) acquireLeadership(ctx context.Context) (bool, error) {
+	ttl := s.Interval * 3
+
+	acquired, err := s.Redis.SetNX(ctx, s.LockKey, s.InstanceID, ttl).Result()
+	if err != nil {
+		return false, err
+	}
+
+	if acquired {
+		return true, nil
+	}
+
+	renewed, err := renewLockScript.Run(ctx, s.Redis, []string{s.LockKey}, s.InstanceID, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return renewed == 1, nil
+}
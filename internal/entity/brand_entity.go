@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Brand struct {
+	ID              uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Name            string    `gorm:"type:varchar(100);not null" json:"name"`
+	Slug            string    `gorm:"type:varchar(120);not null;uniqueIndex" json:"slug"`
+	LogoObject      string    `gorm:"type:varchar(255)" json:"logo_object"`
+	Country         string    `gorm:"type:char(2)" json:"country"`
+	IsOfficialStore bool      `gorm:"not null;default:false" json:"is_official_store"`
+	CreatedAt       time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+}
+
+func (Brand) TableName() string {
+	return "brands"
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BundleComponent struct {
+	ID          uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	BundleID    uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_bundle_component" json:"bundle_id"`
+	ComponentID uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_bundle_component;index" json:"component_id"`
+	Quantity    int       `gorm:"type:int;not null" json:"quantity"`
+	CreatedAt   time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+	Bundle      Product   `gorm:"foreignKey:BundleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Component   Product   `gorm:"foreignKey:ComponentID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"-"`
+}
+
+func (BundleComponent) TableName() string {
+	return "bundle_components"
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type Category struct {
+	ID        uuid.UUID      `gorm:"type:char(36);primaryKey" json:"id"`
+	ParentID  *uuid.UUID     `gorm:"type:char(36);index" json:"parent_id"`
+	Slug      string         `gorm:"type:varchar(150);not null;uniqueIndex" json:"slug"`
+	Name      datatypes.JSON `gorm:"type:json;not null" json:"name"`
+	CreatedAt time.Time      `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+	Children  []Category     `gorm:"foreignKey:ParentID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"-"`
+}
+
+func (Category) TableName() string {
+	return "categories"
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type CategorySpec struct {
+	ID            uuid.UUID      `gorm:"type:char(36);primaryKey" json:"id"`
+	CategoryID    uuid.UUID      `gorm:"type:char(36);not null;uniqueIndex:idx_category_spec_key" json:"category_id"`
+	Key           string         `gorm:"type:varchar(100);not null;uniqueIndex:idx_category_spec_key" json:"key"`
+	Label         datatypes.JSON `gorm:"type:json" json:"label"`
+	Type          string         `gorm:"type:varchar(20);not null" json:"type"`
+	Unit          string         `gorm:"type:varchar(20)" json:"unit"`
+	AllowedValues datatypes.JSON `gorm:"type:json" json:"allowed_values"`
+	Required      bool           `gorm:"not null;default:false" json:"required"`
+	Filterable    bool           `gorm:"not null;default:false" json:"filterable"`
+	Position      int            `gorm:"type:int;not null;default:0" json:"position"`
+	CreatedAt     time.Time      `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time      `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+	Category      Category       `gorm:"foreignKey:CategoryID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (CategorySpec) TableName() string {
+	return "category_specs"
+}
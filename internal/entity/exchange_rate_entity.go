@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExchangeRate struct {
+	Currency  string    `gorm:"type:char(3);primaryKey" json:"currency"`
+	Rate      float64   `gorm:"type:decimal(24,12);not null" json:"rate"`
+	UpdatedBy uuid.UUID `gorm:"type:char(36);not null" json:"updated_by"`
+	CreatedAt time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+}
+
+func (ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FlashSale struct {
+	ID           uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Name         string    `gorm:"type:varchar(150);not null" json:"name"`
+	ProductID    uuid.UUID `gorm:"type:char(36);not null;index" json:"product_id"`
+	SalePrice    int64     `gorm:"type:bigint;not null" json:"sale_price"`
+	Quota        int       `gorm:"type:int;not null" json:"quota"`
+	Sold         int       `gorm:"type:int;not null;default:0" json:"sold"`
+	PerUserLimit int       `gorm:"type:int;not null;default:0" json:"per_user_limit"`
+	StartsAt     time.Time `gorm:"type:timestamp;not null;index" json:"starts_at"`
+	EndsAt       time.Time `gorm:"type:timestamp;not null;index" json:"ends_at"`
+	Active       bool      `gorm:"not null;default:true" json:"active"`
+	CreatedBy    uuid.UUID `gorm:"type:char(36);not null" json:"created_by"`
+	CreatedAt    time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+	Product      Product   `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (FlashSale) TableName() string {
+	return "flash_sales"
+}
+
+func (s *FlashSale) IsLive(now time.Time) bool {
+	return s.Active && !s.StartsAt.After(now) && s.EndsAt.After(now)
+}
@@ -4,15 +4,34 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
 )
 
 type ProductImage struct {
-	ID          uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
-	ProductID   uuid.UUID `gorm:"type:char(36);not null;index" json:"product_id"`
-	ImageObject string    `gorm:"type:varchar(255);not null" json:"image_object"`
-	CreatedAt   time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
-	UpdatedAt   time.Time `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
-	Product     Product   `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	ID               uuid.UUID      `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID        uuid.UUID      `gorm:"type:char(36);not null;index" json:"product_id"`
+	ImageObject      string         `gorm:"type:varchar(255);not null" json:"image_object"`
+	Width            int            `gorm:"type:int;not null;default:0" json:"width"`
+	Height           int            `gorm:"type:int;not null;default:0" json:"height"`
+	ContentHash      string         `gorm:"type:char(64);index" json:"content_hash"`
+	PerceptualHash   *uint64        `gorm:"type:bigint unsigned" json:"perceptual_hash,string,omitempty"`
+	HashBand0        *uint8         `gorm:"type:tinyint unsigned;index" json:"-"`
+	HashBand1        *uint8         `gorm:"type:tinyint unsigned;index" json:"-"`
+	HashBand2        *uint8         `gorm:"type:tinyint unsigned;index" json:"-"`
+	HashBand3        *uint8         `gorm:"type:tinyint unsigned;index" json:"-"`
+	HashBand4        *uint8         `gorm:"type:tinyint unsigned;index" json:"-"`
+	HashBand5        *uint8         `gorm:"type:tinyint unsigned;index" json:"-"`
+	HashBand6        *uint8         `gorm:"type:tinyint unsigned;index" json:"-"`
+	HashBand7        *uint8         `gorm:"type:tinyint unsigned;index" json:"-"`
+	DuplicateGroupID *uuid.UUID     `gorm:"type:char(36);index" json:"duplicate_group_id,omitempty"`
+	Position         int            `gorm:"type:int;not null;default:0" json:"position"`
+	IsPrimary        bool           `gorm:"not null;default:false" json:"is_primary"`
+	AltText          string         `gorm:"type:varchar(255)" json:"alt_text"`
+	Caption          string         `gorm:"type:varchar(500)" json:"caption"`
+	Translations     datatypes.JSON `gorm:"type:json" json:"translations"`
+	CreatedAt        time.Time      `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time      `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+	Product          Product        `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
 }
 
 func (ProductImage) TableName() string {
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductImageUpload struct {
+	ID             uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID      uuid.UUID `gorm:"type:char(36);not null;index" json:"product_id"`
+	ObjectKey      string    `gorm:"type:varchar(255);not null;uniqueIndex" json:"object_key"`
+	OriginalName   string    `gorm:"type:varchar(255);not null" json:"original_name"`
+	ContentType    string    `gorm:"type:varchar(50);not null" json:"content_type"`
+	Size           int64     `gorm:"type:bigint;not null" json:"size"`
+	ChecksumSHA256 string    `gorm:"type:char(64);not null" json:"checksum_sha256"`
+	Status         string    `gorm:"type:varchar(20);not null;default:pending;index" json:"status"`
+	ExpiresAt      time.Time `gorm:"type:timestamp;not null;index" json:"expires_at"`
+	CreatedBy      uuid.UUID `gorm:"type:char(36);not null" json:"created_by"`
+	CreatedAt      time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+	Product        Product   `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (ProductImageUpload) TableName() string {
+	return "product_image_uploads"
+}
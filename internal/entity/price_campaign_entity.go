@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PriceCampaign struct {
+	ID           uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Name         string    `gorm:"type:varchar(150);not null" json:"name"`
+	Scope        string    `gorm:"type:varchar(20);not null;index:idx_price_campaign_target" json:"scope"`
+	TargetID     uuid.UUID `gorm:"type:char(36);not null;index:idx_price_campaign_target" json:"target_id"`
+	DiscountType string    `gorm:"type:varchar(20);not null" json:"discount_type"`
+	Value        int64     `gorm:"type:bigint;not null" json:"value"`
+	Priority     int       `gorm:"type:int;not null;default:0" json:"priority"`
+	StartsAt     time.Time `gorm:"type:timestamp;not null;index" json:"starts_at"`
+	EndsAt       time.Time `gorm:"type:timestamp;not null;index" json:"ends_at"`
+	Active       bool      `gorm:"not null;default:true" json:"active"`
+	Applied      bool      `gorm:"not null;default:false;index" json:"applied"`
+	CreatedBy    uuid.UUID `gorm:"type:char(36);not null" json:"created_by"`
+	CreatedAt    time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+}
+
+func (PriceCampaign) TableName() string {
+	return "price_campaigns"
+}
+
+func (c *PriceCampaign) IsLive(now time.Time) bool {
+	return c.Active && !c.StartsAt.After(now) && c.EndsAt.After(now)
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PriceList struct {
+	ID            uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Name          string    `gorm:"type:varchar(150);not null" json:"name"`
+	CustomerGroup string    `gorm:"type:varchar(50);not null;uniqueIndex" json:"customer_group"`
+	Active        bool      `gorm:"not null;default:true" json:"active"`
+	CreatedBy     uuid.UUID `gorm:"type:char(36);not null" json:"created_by"`
+	CreatedAt     time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+}
+
+func (PriceList) TableName() string {
+	return "price_lists"
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PriceTier struct {
+	ID          uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID   uuid.UUID  `gorm:"type:char(36);not null;index:idx_price_tier_product" json:"product_id"`
+	PriceListID *uuid.UUID `gorm:"type:char(36);index:idx_price_tier_product" json:"price_list_id"`
+	MinQuantity int        `gorm:"type:int;not null" json:"min_quantity"`
+	UnitPrice   int64      `gorm:"type:bigint;not null" json:"unit_price"`
+	CreatedBy   uuid.UUID  `gorm:"type:char(36);not null" json:"created_by"`
+	CreatedAt   time.Time  `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+	Product     Product    `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	PriceList   *PriceList `gorm:"foreignKey:PriceListID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (PriceTier) TableName() string {
+	return "price_tiers"
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductAnswer struct {
+	ID              uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	QuestionID      uuid.UUID  `gorm:"type:char(36);not null;index" json:"question_id"`
+	UserID          uuid.UUID  `gorm:"type:char(36);not null;index" json:"user_id"`
+	Body            string     `gorm:"type:text;not null" json:"body"`
+	IsOfficial      bool       `gorm:"not null;default:false" json:"is_official"`
+	IsVerifiedBuyer bool       `gorm:"not null;default:false" json:"is_verified_buyer"`
+	Status          string     `gorm:"type:varchar(20);not null;default:pending;index" json:"status"`
+	ModerationNote  string     `gorm:"type:varchar(500)" json:"moderation_note"`
+	ModeratedBy     *uuid.UUID `gorm:"type:char(36)" json:"moderated_by"`
+	ModeratedAt     *time.Time `gorm:"type:timestamp NULL" json:"moderated_at"`
+	UpvoteCount     int        `gorm:"type:int;not null;default:0" json:"upvote_count"`
+	CreatedAt       time.Time  `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+}
+
+func (ProductAnswer) TableName() string {
+	return "product_answers"
+}
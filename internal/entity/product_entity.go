@@ -5,22 +5,40 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 type Product struct {
-	ID          uuid.UUID      `gorm:"type:char(36);primaryKey" json:"id"`
-	Name        string         `gorm:"type:varchar(255);not null" json:"name"`
-	Description string         `gorm:"type:text" json:"description"`
-	Category    datatypes.JSON `gorm:"type:json" json:"category"`
-	Brand       string         `gorm:"type:varchar(100);not null" json:"brand"`
-	Color       datatypes.JSON `gorm:"type:json" json:"color"`
-	Specs       datatypes.JSON `gorm:"type:json" json:"specs"`
-	Price       float64        `gorm:"type:decimal(12,2);not null" json:"price"`
-	Quantity    int            `gorm:"type:int;not null" json:"quantity"`
-	CreatedBy   uuid.UUID      `gorm:"type:char(36);not null;column:created_by" json:"created_by"`
-	CreatedAt   time.Time      `gorm:"type:timestamp;not null;column:created_at;autoCreateTime" json:"created_at"`
-	UpdatedAt   time.Time      `gorm:"type:timestamp;not null;column:updated_at;autoUpdateTime" json:"updated_at"`
-	Images      []ProductImage `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"images"`
+	ID              uuid.UUID      `gorm:"type:char(36);primaryKey" json:"id"`
+	Name            string         `gorm:"type:varchar(255);not null" json:"name"`
+	Slug            string         `gorm:"type:varchar(191);index" json:"slug"`
+	Description     string         `gorm:"type:text" json:"description"`
+	Translations    datatypes.JSON `gorm:"type:json" json:"translations"`
+	Category        datatypes.JSON `gorm:"type:json" json:"category"`
+	BrandID         *uuid.UUID     `gorm:"type:char(36);index" json:"brand_id"`
+	Brand           string         `gorm:"type:varchar(100);not null" json:"brand"`
+	Color           datatypes.JSON `gorm:"type:json" json:"color"`
+	Specs           datatypes.JSON `gorm:"type:json" json:"specs"`
+	Price           int64          `gorm:"type:bigint;not null" json:"price"`
+	Currency        string         `gorm:"type:char(3);not null;default:IDR" json:"currency"`
+	PriceDroppedAt  *time.Time     `gorm:"type:timestamp NULL;index" json:"price_dropped_at"`
+	CompareAtPrice  *int64         `gorm:"type:bigint" json:"compare_at_price"`
+	EffectivePrice  int64          `gorm:"type:bigint;not null;default:0;index" json:"effective_price"`
+	PriceCampaignID *uuid.UUID     `gorm:"type:char(36);index" json:"price_campaign_id"`
+	Type            string         `gorm:"type:varchar(20);not null;default:simple;index" json:"type"`
+	Quantity        int            `gorm:"type:int;not null" json:"quantity"`
+	RatingAverage   float64        `gorm:"type:decimal(3,2);not null;default:0;index" json:"rating_average"`
+	RatingCount     int            `gorm:"type:int;not null;default:0" json:"rating_count"`
+	Status          string         `gorm:"type:varchar(20);not null;default:published;index" json:"status"`
+	PublishAt       *time.Time     `gorm:"type:timestamp NULL;index" json:"publish_at"`
+	UnpublishAt     *time.Time     `gorm:"type:timestamp NULL;index" json:"unpublish_at"`
+	CreatedBy       uuid.UUID      `gorm:"type:char(36);not null;column:created_by" json:"created_by"`
+	CreatedAt       time.Time      `gorm:"type:timestamp;not null;column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time      `gorm:"type:timestamp;not null;column:updated_at;autoUpdateTime" json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	Images          []ProductImage `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"images"`
+	Categories      []Category     `gorm:"many2many:product_categories;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"categories"`
+	BrandDetail     *Brand         `gorm:"foreignKey:BrandID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"-"`
 }
 
 func (Product) TableName() string {
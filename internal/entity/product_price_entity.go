@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductPrice struct {
+	ID            uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID     uuid.UUID `gorm:"type:char(36);not null;index:idx_product_price_effective" json:"product_id"`
+	Price         int64     `gorm:"type:bigint;not null" json:"price"`
+	EffectiveFrom time.Time `gorm:"type:timestamp;not null;index:idx_product_price_effective" json:"effective_from"`
+	ActorID       uuid.UUID `gorm:"type:char(36);not null" json:"actor_id"`
+	CreatedAt     time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	Product       Product   `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (ProductPrice) TableName() string {
+	return "product_prices"
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductQAVote struct {
+	ID         uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	TargetType string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_product_qa_vote" json:"target_type"`
+	TargetID   uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_product_qa_vote" json:"target_id"`
+	UserID     uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_product_qa_vote" json:"user_id"`
+	CreatedAt  time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+}
+
+func (ProductQAVote) TableName() string {
+	return "product_qa_votes"
+}
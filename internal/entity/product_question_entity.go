@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductQuestion struct {
+	ID             uuid.UUID       `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID      uuid.UUID       `gorm:"type:char(36);not null;index" json:"product_id"`
+	UserID         uuid.UUID       `gorm:"type:char(36);not null;index" json:"user_id"`
+	Body           string          `gorm:"type:text;not null" json:"body"`
+	Status         string          `gorm:"type:varchar(20);not null;default:pending;index" json:"status"`
+	ModerationNote string          `gorm:"type:varchar(500)" json:"moderation_note"`
+	ModeratedBy    *uuid.UUID      `gorm:"type:char(36)" json:"moderated_by"`
+	ModeratedAt    *time.Time      `gorm:"type:timestamp NULL" json:"moderated_at"`
+	UpvoteCount    int             `gorm:"type:int;not null;default:0" json:"upvote_count"`
+	AnswerCount    int             `gorm:"type:int;not null;default:0" json:"answer_count"`
+	CreatedAt      time.Time       `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time       `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+	Answers        []ProductAnswer `gorm:"foreignKey:QuestionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"answers"`
+	Product        Product         `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (ProductQuestion) TableName() string {
+	return "product_questions"
+}
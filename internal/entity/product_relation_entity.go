@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductRelation struct {
+	ID               uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID        uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_product_relation" json:"product_id"`
+	RelatedProductID uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_product_relation;index" json:"related_product_id"`
+	Type             string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_product_relation" json:"type"`
+	Position         int       `gorm:"type:int;not null;default:0" json:"position"`
+	CreatedBy        uuid.UUID `gorm:"type:char(36);not null" json:"created_by"`
+	CreatedAt        time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	Product          Product   `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	RelatedProduct   Product   `gorm:"foreignKey:RelatedProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (ProductRelation) TableName() string {
+	return "product_relations"
+}
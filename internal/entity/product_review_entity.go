@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductReview struct {
+	ID             uuid.UUID            `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID      uuid.UUID            `gorm:"type:char(36);not null;uniqueIndex:idx_product_review_user" json:"product_id"`
+	UserID         uuid.UUID            `gorm:"type:char(36);not null;uniqueIndex:idx_product_review_user;index" json:"user_id"`
+	Rating         int                  `gorm:"type:tinyint;not null" json:"rating"`
+	Title          string               `gorm:"type:varchar(150)" json:"title"`
+	Body           string               `gorm:"type:text;not null" json:"body"`
+	Status         string               `gorm:"type:varchar(20);not null;default:pending;index" json:"status"`
+	ModerationNote string               `gorm:"type:varchar(500)" json:"moderation_note"`
+	ModeratedBy    *uuid.UUID           `gorm:"type:char(36)" json:"moderated_by"`
+	ModeratedAt    *time.Time           `gorm:"type:timestamp NULL" json:"moderated_at"`
+	HelpfulCount   int                  `gorm:"type:int;not null;default:0" json:"helpful_count"`
+	CreatedAt      time.Time            `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time            `gorm:"type:timestamp;not null;autoUpdateTime" json:"updated_at"`
+	Photos         []ProductReviewPhoto `gorm:"foreignKey:ReviewID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"photos"`
+	Product        Product              `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (ProductReview) TableName() string {
+	return "product_reviews"
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductReviewPhoto struct {
+	ID          uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	ReviewID    uuid.UUID `gorm:"type:char(36);not null;index" json:"review_id"`
+	PhotoObject string    `gorm:"type:varchar(255);not null" json:"photo_object"`
+	CreatedAt   time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+}
+
+func (ProductReviewPhoto) TableName() string {
+	return "product_review_photos"
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductReviewVote struct {
+	ID        uuid.UUID     `gorm:"type:char(36);primaryKey" json:"id"`
+	ReviewID  uuid.UUID     `gorm:"type:char(36);not null;uniqueIndex:idx_product_review_vote" json:"review_id"`
+	UserID    uuid.UUID     `gorm:"type:char(36);not null;uniqueIndex:idx_product_review_vote" json:"user_id"`
+	CreatedAt time.Time     `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	Review    ProductReview `gorm:"foreignKey:ReviewID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (ProductReviewVote) TableName() string {
+	return "product_review_votes"
+}
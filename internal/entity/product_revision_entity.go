@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type ProductRevision struct {
+	ID        uuid.UUID      `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID uuid.UUID      `gorm:"type:char(36);not null;uniqueIndex:idx_product_revision" json:"product_id"`
+	Revision  int            `gorm:"type:int;not null;uniqueIndex:idx_product_revision" json:"revision"`
+	Snapshot  datatypes.JSON `gorm:"type:json;not null" json:"snapshot"`
+	Diff      datatypes.JSON `gorm:"type:json" json:"diff"`
+	ActorID   uuid.UUID      `gorm:"type:char(36);not null" json:"actor_id"`
+	RequestID string         `gorm:"type:varchar(64)" json:"request_id"`
+	CreatedAt time.Time      `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	Product   Product        `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (ProductRevision) TableName() string {
+	return "product_revisions"
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductSlug struct {
+	ID        uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID uuid.UUID `gorm:"type:char(36);not null;index" json:"product_id"`
+	Slug      string    `gorm:"type:varchar(191);not null;uniqueIndex" json:"slug"`
+	CreatedAt time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	Product   Product   `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (ProductSlug) TableName() string {
+	return "product_slugs"
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductStatusTransition struct {
+	ID         uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	ProductID  uuid.UUID `gorm:"type:char(36);not null;index" json:"product_id"`
+	FromStatus string    `gorm:"type:varchar(20)" json:"from_status"`
+	ToStatus   string    `gorm:"type:varchar(20);not null" json:"to_status"`
+	ActorID    uuid.UUID `gorm:"type:char(36);not null" json:"actor_id"`
+	Note       string    `gorm:"type:varchar(500)" json:"note"`
+	CreatedAt  time.Time `gorm:"type:timestamp;not null;autoCreateTime" json:"created_at"`
+	Product    Product   `gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+func (ProductStatusTransition) TableName() string {
+	return "product_status_transitions"
+}
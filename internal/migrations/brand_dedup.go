@@ -0,0 +1,88 @@
+package migrations
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/utils"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+func DeduplicateBrands(db *gorm.DB, logger *logrus.Logger) ([]entity.Product, error) {
+	var products []entity.Product
+	if err := db.Preload("Images").Preload("Categories").Where("brand_id IS NULL").Find(&products).Error; err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string][]int)
+	var order []string
+	for i, product := range products {
+		slug := utils.Slugify(product.Brand)
+		if slug == "" {
+			logger.Warnf("Skipping product %s: empty brand", product.ID)
+			continue
+		}
+		if _, ok := groups[slug]; !ok {
+			order = append(order, slug)
+		}
+		groups[slug] = append(groups[slug], i)
+	}
+
+	var updated []entity.Product
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, slug := range order {
+			indexes := groups[slug]
+
+			var brand entity.Brand
+			err := tx.Where("slug = ?", slug).First(&brand).Error
+			switch {
+			case err == gorm.ErrRecordNotFound:
+				brand = entity.Brand{
+					ID:   uuid.New(),
+					Name: canonicalBrandName(products, indexes),
+					Slug: slug,
+				}
+				if err := tx.Create(&brand).Error; err != nil {
+					return err
+				}
+				logger.Infof("Created brand '%s' (%s)", brand.Name, brand.Slug)
+			case err != nil:
+				return err
+			}
+
+			for _, i := range indexes {
+				products[i].BrandID = &brand.ID
+				products[i].Brand = brand.Name
+				if err := tx.Model(&products[i]).UpdateColumns(map[string]any{
+					"brand_id": brand.ID,
+					"brand":    brand.Name,
+				}).Error; err != nil {
+					return err
+				}
+				updated = append(updated, products[i])
+			}
+			logger.Infof("Linked %d products to brand '%s'", len(indexes), brand.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func canonicalBrandName(products []entity.Product, indexes []int) string {
+	counts := make(map[string]int)
+	best := ""
+	for _, i := range indexes {
+		name := strings.Join(strings.Fields(products[i].Brand), " ")
+		counts[name]++
+		if best == "" || counts[name] > counts[best] {
+			best = name
+		}
+	}
+	return best
+}
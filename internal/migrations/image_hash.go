@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"fmt"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+// migrateNullablePerceptualHash lets perceptual_hash be NULL for images that
+// were never hashed, since 0 is a valid hash of a flat image. Rows written
+// before hashing existed stored 0, so they become NULL here.
+func migrateNullablePerceptualHash(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&entity.ProductImage{}) {
+		return nil
+	}
+
+	types, err := db.Migrator().ColumnTypes(&entity.ProductImage{})
+	if err != nil {
+		return err
+	}
+
+	for _, columnType := range types {
+		if columnType.Name() != "perceptual_hash" {
+			continue
+		}
+		if nullable, ok := columnType.Nullable(); !ok || nullable {
+			return nil
+		}
+
+		if db.Migrator().HasIndex(&entity.ProductImage{}, "idx_product_images_perceptual_hash") {
+			if err := db.Migrator().DropIndex(&entity.ProductImage{}, "idx_product_images_perceptual_hash"); err != nil {
+				return err
+			}
+		}
+
+		statements := []string{
+			"ALTER TABLE product_images MODIFY perceptual_hash BIGINT UNSIGNED NULL DEFAULT NULL",
+			"UPDATE product_images SET perceptual_hash = NULL WHERE perceptual_hash = 0",
+		}
+		for _, statement := range statements {
+			if err := db.Exec(statement).Error; err != nil {
+				return fmt.Errorf("migrate perceptual_hash to nullable: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func backfillPerceptualHashBands(db *gorm.DB) error {
+	updates := make(map[string]any, utils.PerceptualHashBandCount)
+	for i := range utils.PerceptualHashBandCount {
+		shift := 8 * (utils.PerceptualHashBandCount - 1 - i)
+		updates[fmt.Sprintf("hash_band%d", i)] = gorm.Expr("(perceptual_hash >> ?) & 255", shift)
+	}
+
+	return db.Model(&entity.ProductImage{}).
+		Where("perceptual_hash IS NOT NULL AND hash_band0 IS NULL").
+		UpdateColumns(updates).Error
+}
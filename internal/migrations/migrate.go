@@ -7,5 +7,29 @@ import (
 )
 
 func Migrate(db *gorm.DB) error {
-	return db.AutoMigrate(&entity.Product{}, &entity.ProductImage{})
+	if err := migrateMoneyColumns(db); err != nil {
+		return err
+	}
+
+	if err := migrateNullablePerceptualHash(db); err != nil {
+		return err
+	}
+
+	if err := db.AutoMigrate(&entity.Category{}, &entity.CategorySpec{}, &entity.Brand{}, &entity.Product{}, &entity.ProductSlug{}, &entity.ProductStatusTransition{}, &entity.ProductRevision{}, &entity.ProductPrice{}, &entity.PriceCampaign{}, &entity.FlashSale{}, &entity.ExchangeRate{}, &entity.PriceList{}, &entity.PriceTier{}, &entity.BundleComponent{}, &entity.ProductRelation{}, &entity.ProductReview{}, &entity.ProductReviewPhoto{}, &entity.ProductReviewVote{}, &entity.ProductQuestion{}, &entity.ProductAnswer{}, &entity.ProductQAVote{}, &entity.ProductImage{}, &entity.ProductImageUpload{}); err != nil {
+		return err
+	}
+
+	if err := backfillProductSlugs(db); err != nil {
+		return err
+	}
+
+	if err := backfillProductPrices(db); err != nil {
+		return err
+	}
+
+	if err := backfillEffectivePrices(db); err != nil {
+		return err
+	}
+
+	return backfillPerceptualHashBands(db)
 }
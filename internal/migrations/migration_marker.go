@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Data migrations that cannot be detected from the schema record a marker
+// row once they have run, so re-running the migration does not apply them
+// twice.
+func ensureMigrationMarkers(db *gorm.DB) error {
+	return db.Exec("CREATE TABLE IF NOT EXISTS migration_markers (name VARCHAR(100) NOT NULL PRIMARY KEY, applied_at TIMESTAMP NOT NULL)").Error
+}
+
+func hasMigrationMarker(db *gorm.DB, name string) (bool, error) {
+	var total int64
+	if err := db.Table("migration_markers").Where("name = ?", name).Count(&total).Error; err != nil {
+		return false, err
+	}
+
+	return total > 0, nil
+}
+
+func setMigrationMarker(db *gorm.DB, name string) error {
+	return db.Exec("INSERT INTO migration_markers (name, applied_at) VALUES (?, ?)", name, time.Now()).Error
+}
@@ -0,0 +1,142 @@
+package migrations
+
+import (
+	"fmt"
+	"golectro-product/internal/entity"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Every supported currency has an exponent of 2, so decimal amounts become
+// minor units by scaling with 100.
+const minorUnitScale = 100
+
+type moneyColumn struct {
+	model  any
+	table  string
+	column string
+}
+
+var moneyColumns = []moneyColumn{
+	{&entity.Product{}, "products", "price"},
+	{&entity.Product{}, "products", "compare_at_price"},
+	{&entity.Product{}, "products", "effective_price"},
+	{&entity.ProductPrice{}, "product_prices", "price"},
+	{&entity.FlashSale{}, "flash_sales", "sale_price"},
+	{&entity.PriceTier{}, "price_tiers", "unit_price"},
+	{&entity.PriceCampaign{}, "price_campaigns", "value"},
+}
+
+const revisionPricesMarker = "product_revisions_minor_units"
+
+var revisionMoneyPaths = []string{
+	"$.price",
+	"$.compare_at_price",
+}
+
+// migrateMoneyColumns converts the decimal price columns to bigint minor
+// units. Each column is scaled into a <column>_minor shadow column and then
+// swapped in with a single ALTER, so a run that stops halfway resumes from
+// the shadow column instead of scaling the same amounts twice. Columns that
+// are already bigint are skipped, so the step is safe to run on every
+// migration.
+func migrateMoneyColumns(db *gorm.DB) error {
+	pending, err := decimalColumns(db)
+	if err != nil {
+		return err
+	}
+
+	for _, column := range pending {
+		if column.table == "products" && column.column == "price" {
+			if err := migrateRevisionPrices(db); err != nil {
+				return err
+			}
+		}
+
+		shadow := column.column + "_minor"
+		if !db.Migrator().HasColumn(column.model, shadow) {
+			if err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s BIGINT NULL", column.table, shadow)).Error; err != nil {
+				return fmt.Errorf("add %s.%s: %w", column.table, shadow, err)
+			}
+		}
+
+		statements := []string{
+			fmt.Sprintf("UPDATE %[1]s SET %[3]s = ROUND(%[2]s * %[4]d) WHERE %[3]s IS NULL AND %[2]s IS NOT NULL", column.table, column.column, shadow, minorUnitScale),
+			fmt.Sprintf("ALTER TABLE %[1]s DROP COLUMN %[2]s, CHANGE %[3]s %[2]s BIGINT NULL", column.table, column.column, shadow),
+		}
+		for _, statement := range statements {
+			if err := db.Exec(statement).Error; err != nil {
+				return fmt.Errorf("migrate %s.%s to minor units: %w", column.table, column.column, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func decimalColumns(db *gorm.DB) ([]moneyColumn, error) {
+	var pending []moneyColumn
+	for _, column := range moneyColumns {
+		if !db.Migrator().HasTable(column.model) {
+			continue
+		}
+
+		types, err := db.Migrator().ColumnTypes(column.model)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, columnType := range types {
+			if columnType.Name() == column.column && strings.EqualFold(columnType.DatabaseTypeName(), "decimal") {
+				pending = append(pending, column)
+			}
+		}
+	}
+
+	return pending, nil
+}
+
+// migrateRevisionPrices scales the prices stored in revision snapshots and
+// diffs, so rolling back to an older revision restores the same amount. The
+// updates and the marker are written in one transaction, so the snapshots are
+// scaled exactly once even if a later step of the migration fails.
+func migrateRevisionPrices(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&entity.ProductRevision{}) {
+		return nil
+	}
+
+	if err := ensureMigrationMarkers(db); err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		done, err := hasMigrationMarker(tx, revisionPricesMarker)
+		if err != nil || done {
+			return err
+		}
+
+		for _, path := range revisionMoneyPaths {
+			targets := []struct {
+				column string
+				path   string
+			}{
+				{"snapshot", path},
+				{"diff", path + ".from"},
+				{"diff", path + ".to"},
+			}
+
+			for _, target := range targets {
+				statement := fmt.Sprintf(
+					"UPDATE product_revisions SET %[1]s = JSON_SET(%[1]s, '%[2]s', CAST(ROUND(JSON_EXTRACT(%[1]s, '%[2]s') * %[3]d) AS SIGNED)) WHERE JSON_TYPE(JSON_EXTRACT(%[1]s, '%[2]s')) IN ('INTEGER', 'DOUBLE', 'DECIMAL')",
+					target.column, target.path, minorUnitScale,
+				)
+				if err := tx.Exec(statement).Error; err != nil {
+					return fmt.Errorf("migrate revision prices at %s: %w", target.path, err)
+				}
+			}
+		}
+
+		return setMigrationMarker(tx, revisionPricesMarker)
+	})
+}
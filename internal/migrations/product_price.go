@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func backfillProductPrices(db *gorm.DB) error {
+	var products []entity.Product
+	if err := db.Unscoped().
+		Where("NOT EXISTS (SELECT 1 FROM product_prices WHERE product_prices.product_id = products.id)").
+		Find(&products).Error; err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, product := range products {
+			if err := tx.Create(&entity.ProductPrice{
+				ID:            uuid.New(),
+				ProductID:     product.ID,
+				Price:         product.Price,
+				EffectiveFrom: product.CreatedAt,
+				ActorID:       product.CreatedBy,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func backfillEffectivePrices(db *gorm.DB) error {
+	return db.Unscoped().Model(&entity.Product{}).
+		Where("price_campaign_id IS NULL AND effective_price <> price").
+		UpdateColumn("effective_price", gorm.Expr("price")).Error
+}
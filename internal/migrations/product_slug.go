@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/utils"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func backfillProductSlugs(db *gorm.DB) error {
+	var products []entity.Product
+	if err := db.Where("slug IS NULL OR slug = ''").Order("created_at ASC").Find(&products).Error; err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, product := range products {
+			slug, err := utils.UniqueSlug(product.Name, func(slug string) (bool, error) {
+				var total int64
+				err := tx.Model(&entity.ProductSlug{}).Where("slug = ? AND product_id <> ?", slug, product.ID).Count(&total).Error
+				return total > 0, err
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := tx.Model(&product).UpdateColumn("slug", slug).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Create(&entity.ProductSlug{ID: uuid.New(), ProductID: product.ID, Slug: slug}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
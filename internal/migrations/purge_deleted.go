@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func PurgeDeletedProducts(db *gorm.DB, cutoff time.Time) ([]entity.Product, error) {
+	var products []entity.Product
+	if err := db.Unscoped().Preload("Images").Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).Find(&products).Error; err != nil {
+		return nil, err
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for i := range products {
+			if err := tx.Unscoped().Model(&products[i]).Association("Categories").Clear(); err != nil {
+				return err
+			}
+			if err := tx.Unscoped().Where("product_id = ?", products[i].ID).Delete(&entity.ProductImage{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("bundle_id = ? OR component_id = ?", products[i].ID, products[i].ID).Delete(&entity.BundleComponent{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("product_id = ? OR related_product_id = ?", products[i].ID, products[i].ID).Delete(&entity.ProductRelation{}).Error; err != nil {
+				return err
+			}
+			questions := tx.Model(&entity.ProductQuestion{}).Select("id").Where("product_id = ?", products[i].ID)
+			answers := tx.Model(&entity.ProductAnswer{}).Select("id").Where("question_id IN (?)", questions)
+			if err := tx.Where("(target_type = ? AND target_id IN (?)) OR (target_type = ? AND target_id IN (?))", model.QATargetQuestion, questions, model.QATargetAnswer, answers).Delete(&entity.ProductQAVote{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Unscoped().Delete(&products[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
@@ -12,8 +12,24 @@ import (
 func Seeder(db *gorm.DB, logger *logrus.Logger) error {
 	logger.Info("Seeding database...")
 
+	seedFromJSON("internal/migrations/json/categories.json", &[]entity.Category{}, db, logger)
+	seedFromJSON("internal/migrations/json/category_specs.json", &[]entity.CategorySpec{}, db, logger)
+	seedFromJSON("internal/migrations/json/brands.json", &[]entity.Brand{}, db, logger)
 	seedFromJSON("internal/migrations/json/products.json", &[]entity.Product{}, db, logger)
 	seedFromJSON("internal/migrations/json/product_images.json", &[]entity.ProductImage{}, db, logger)
+	seedProductCategories(db, logger)
+
+	if err := backfillProductSlugs(db); err != nil {
+		logger.Warnf("Failed to backfill product slugs: %v", err)
+	}
+
+	if err := backfillProductPrices(db); err != nil {
+		logger.Warnf("Failed to backfill product prices: %v", err)
+	}
+
+	if err := backfillEffectivePrices(db); err != nil {
+		logger.Warnf("Failed to backfill effective prices: %v", err)
+	}
 
 	return nil
 }
@@ -46,3 +62,38 @@ func seedFromJSON[T any](filePath string, out *[]T, db *gorm.DB, log *logrus.Log
 		log.Infof("Skipping insert for %s: table not empty", filePath)
 	}
 }
+
+func seedProductCategories(db *gorm.DB, log *logrus.Logger) {
+	var products []entity.Product
+	if err := db.Preload("Categories").Find(&products).Error; err != nil {
+		log.Warnf("Failed to load products for category links: %v", err)
+		return
+	}
+
+	for _, product := range products {
+		if len(product.Categories) > 0 {
+			continue
+		}
+
+		var slugs []string
+		if err := json.Unmarshal(product.Category, &slugs); err != nil || len(slugs) == 0 {
+			continue
+		}
+
+		var categories []entity.Category
+		if err := db.Where("slug IN ?", slugs).Find(&categories).Error; err != nil {
+			log.Warnf("Failed to find categories for product %s: %v", product.ID, err)
+			continue
+		}
+
+		if len(categories) != len(slugs) {
+			log.Warnf("Product %s references unknown categories %v", product.ID, slugs)
+		}
+
+		if err := db.Model(&product).Omit("Categories.*").Association("Categories").Append(categories); err != nil {
+			log.Warnf("Failed to link categories for product %s: %v", product.ID, err)
+			continue
+		}
+		log.Infof("Linked %d categories to product %s", len(categories), product.ID)
+	}
+}
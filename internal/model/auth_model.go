@@ -6,8 +6,9 @@ import (
 )
 
 type Auth struct {
-	ID       uuid.UUID      `json:"id"`
-	Username string         `json:"username" validate:"required,min=3,max=50"`
-	Email    string         `json:"email" validate:"required,email"`
-	Roles    datatypes.JSON `json:"roles" validate:"required,dive,required"`
+	ID            uuid.UUID      `json:"id"`
+	Username      string         `json:"username" validate:"required,min=3,max=50"`
+	Email         string         `json:"email" validate:"required,email"`
+	Roles         datatypes.JSON `json:"roles" validate:"required,dive,required"`
+	CustomerGroup string         `json:"customer_group"`
 }
@@ -0,0 +1,23 @@
+package model
+
+import "github.com/google/uuid"
+
+type (
+	BrandRequest struct {
+		Name            string `json:"name" validate:"required,max=100"`
+		Slug            string `json:"slug" validate:"omitempty,max=120"`
+		Country         string `json:"country" validate:"omitempty,iso3166_1_alpha2"`
+		IsOfficialStore bool   `json:"is_official_store"`
+	}
+
+	BrandResponse struct {
+		ID              uuid.UUID `json:"id"`
+		Name            string    `json:"name"`
+		Slug            string    `json:"slug"`
+		LogoObject      string    `json:"logo_object,omitempty"`
+		LogoURL         string    `json:"logo_url,omitempty"`
+		Country         string    `json:"country,omitempty"`
+		IsOfficialStore bool      `json:"is_official_store"`
+		ProductCount    int64     `json:"product_count"`
+	}
+)
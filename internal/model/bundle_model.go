@@ -0,0 +1,28 @@
+package model
+
+import "github.com/google/uuid"
+
+const (
+	ProductTypeSimple = "simple"
+	ProductTypeBundle = "bundle"
+)
+
+type (
+	BundleComponentRequest struct {
+		ComponentID uuid.UUID `json:"component_id" validate:"required"`
+		Quantity    int       `json:"quantity" validate:"required,gte=1"`
+	}
+
+	BundleComponentsRequest struct {
+		Components []BundleComponentRequest `json:"components" validate:"required,min=1,unique=ComponentID,dive"`
+	}
+
+	BundleComponentResponse struct {
+		ComponentID    uuid.UUID `json:"component_id"`
+		Name           string    `json:"name"`
+		Slug           string    `json:"slug"`
+		Quantity       int       `json:"quantity"`
+		Available      int       `json:"available"`
+		EffectivePrice int64     `json:"effective_price"`
+	}
+)
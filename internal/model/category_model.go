@@ -0,0 +1,58 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type (
+	CategoryRequest struct {
+		ParentID *uuid.UUID `json:"parent_id"`
+		Slug     string     `json:"slug" validate:"omitempty,max=150"`
+		Name     Message    `json:"name" validate:"required,dive,keys,oneof=en id,endkeys,required,max=100"`
+	}
+
+	CategoryResponse struct {
+		ID       uuid.UUID           `json:"id"`
+		ParentID *uuid.UUID          `json:"parent_id"`
+		Slug     string              `json:"slug"`
+		Name     datatypes.JSON      `json:"name"`
+		Children []*CategoryResponse `json:"children,omitempty"`
+	}
+)
+
+const (
+	SpecTypeString  = "string"
+	SpecTypeNumber  = "number"
+	SpecTypeBoolean = "boolean"
+	SpecTypeEnum    = "enum"
+	SpecTypeList    = "list"
+)
+
+type (
+	CategorySpecRequest struct {
+		Key           string   `json:"key" validate:"required,max=100"`
+		Label         Message  `json:"label" validate:"omitempty,dive,keys,oneof=en id,endkeys,required,max=100"`
+		Type          string   `json:"type" validate:"required,oneof=string number boolean enum list"`
+		Unit          string   `json:"unit" validate:"omitempty,max=20"`
+		AllowedValues []string `json:"allowed_values" validate:"required_if=Type enum,omitempty,dive,required,max=100"`
+		Required      bool     `json:"required"`
+		Filterable    bool     `json:"filterable"`
+	}
+
+	CategorySpecsRequest struct {
+		Specs []CategorySpecRequest `json:"specs" validate:"dive"`
+	}
+
+	CategorySpecResponse struct {
+		CategoryID    uuid.UUID      `json:"category_id"`
+		Key           string         `json:"key"`
+		Label         datatypes.JSON `json:"label"`
+		Type          string         `json:"type"`
+		Unit          string         `json:"unit,omitempty"`
+		AllowedValues datatypes.JSON `json:"allowed_values,omitempty"`
+		Required      bool           `json:"required"`
+		Filterable    bool           `json:"filterable"`
+		Inherited     bool           `json:"inherited"`
+	}
+)
@@ -0,0 +1,17 @@
+package converter
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+)
+
+func ToBrandResponse(brand *entity.Brand) *model.BrandResponse {
+	return &model.BrandResponse{
+		ID:              brand.ID,
+		Name:            brand.Name,
+		Slug:            brand.Slug,
+		LogoObject:      brand.LogoObject,
+		Country:         brand.Country,
+		IsOfficialStore: brand.IsOfficialStore,
+	}
+}
@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"encoding/json"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+
+	"gorm.io/datatypes"
+)
+
+func ToCategoryResponse(category *entity.Category) *model.CategoryResponse {
+	return &model.CategoryResponse{
+		ID:       category.ID,
+		ParentID: category.ParentID,
+		Slug:     category.Slug,
+		Name:     category.Name,
+	}
+}
+
+func ToCategoryResponses(categories []entity.Category) []*model.CategoryResponse {
+	responses := make([]*model.CategoryResponse, 0, len(categories))
+	for i := range categories {
+		responses = append(responses, ToCategoryResponse(&categories[i]))
+	}
+	return responses
+}
+
+func ToCategorySlugs(categories []entity.Category) datatypes.JSON {
+	slugs := make([]string, 0, len(categories))
+	for _, category := range categories {
+		slugs = append(slugs, category.Slug)
+	}
+
+	data, _ := json.Marshal(slugs)
+	return datatypes.JSON(data)
+}
+
+func ToCategorySpecResponse(spec *entity.CategorySpec) *model.CategorySpecResponse {
+	return &model.CategorySpecResponse{
+		CategoryID:    spec.CategoryID,
+		Key:           spec.Key,
+		Label:         spec.Label,
+		Type:          spec.Type,
+		Unit:          spec.Unit,
+		AllowedValues: spec.AllowedValues,
+		Required:      spec.Required,
+		Filterable:    spec.Filterable,
+	}
+}
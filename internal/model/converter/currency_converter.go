@@ -0,0 +1,74 @@
+package converter
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/utils"
+)
+
+func ToExchangeRateResponse(rate *entity.ExchangeRate) *model.ExchangeRateResponse {
+	return &model.ExchangeRateResponse{
+		BaseCurrency: model.BaseCurrency,
+		Currency:     rate.Currency,
+		Rate:         rate.Rate,
+		UpdatedBy:    rate.UpdatedBy,
+		UpdatedAt:    rate.UpdatedAt,
+	}
+}
+
+func ToProductPricing(product *model.ProductResponse, rate float64, currency string) *model.ProductPricing {
+	source := SourceCurrency(product.Currency)
+	convert := func(amount int64) model.Money {
+		return utils.ConvertMoney(utils.ToMoney(amount, source), rate, currency)
+	}
+	convertOptional := func(amount *int64) *model.Money {
+		if amount == nil {
+			return nil
+		}
+		money := convert(*amount)
+		return &money
+	}
+
+	pricing := &model.ProductPricing{
+		Currency:       currency,
+		Rate:           rate,
+		Price:          convert(product.Price),
+		EffectivePrice: convert(product.EffectivePrice),
+		CompareAtPrice: convertOptional(product.CompareAtPrice),
+		LowestPrice30d: convertOptional(product.LowestPrice30d),
+	}
+	if product.FlashSale != nil {
+		pricing.FlashSalePrice = convertOptional(&product.FlashSale.SalePrice)
+	}
+
+	return pricing
+}
+
+func ToDocumentPricing(document map[string]any, rate float64, currency string) *model.ProductPricing {
+	product := &model.ProductResponse{Currency: DocumentCurrency(document)}
+	product.Price = documentAmount(document["price"])
+	product.EffectivePrice = documentAmount(document["effective_price"])
+	if compareAt, ok := document["compare_at_price"]; ok && compareAt != nil {
+		amount := documentAmount(compareAt)
+		product.CompareAtPrice = &amount
+	}
+
+	return ToProductPricing(product, rate, currency)
+}
+
+func documentAmount(value any) int64 {
+	amount, _ := value.(float64)
+	return int64(amount)
+}
+
+func DocumentCurrency(document map[string]any) string {
+	currency, _ := document["currency"].(string)
+	return SourceCurrency(currency)
+}
+
+func SourceCurrency(currency string) string {
+	if normalized := utils.NormalizeCurrency(currency); normalized != "" {
+		return normalized
+	}
+	return model.BaseCurrency
+}
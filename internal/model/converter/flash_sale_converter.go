@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"time"
+)
+
+func ToFlashSaleResponse(sale *entity.FlashSale, remaining int) *model.FlashSaleResponse {
+	return &model.FlashSaleResponse{
+		ID:           sale.ID,
+		Name:         sale.Name,
+		ProductID:    sale.ProductID,
+		SalePrice:    sale.SalePrice,
+		Quota:        sale.Quota,
+		Sold:         sale.Sold,
+		Remaining:    remaining,
+		PerUserLimit: sale.PerUserLimit,
+		StartsAt:     sale.StartsAt,
+		EndsAt:       sale.EndsAt,
+		Active:       sale.Active,
+		Live:         sale.IsLive(time.Now()),
+		CreatedBy:    sale.CreatedBy,
+		CreatedAt:    sale.CreatedAt,
+		UpdatedAt:    sale.UpdatedAt,
+	}
+}
+
+func ToFlashSaleSummary(sale *entity.FlashSale, remaining int) *model.FlashSaleSummary {
+	return &model.FlashSaleSummary{
+		ID:           sale.ID,
+		SalePrice:    sale.SalePrice,
+		Remaining:    remaining,
+		PerUserLimit: sale.PerUserLimit,
+		EndsAt:       sale.EndsAt,
+	}
+}
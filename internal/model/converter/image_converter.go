@@ -0,0 +1,67 @@
+package converter
+
+import (
+	"encoding/json"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+
+	"gorm.io/datatypes"
+)
+
+func ToProductImageResponse(image *entity.ProductImage, locale string) *model.ProductImageResponse {
+	response := &model.ProductImageResponse{
+		ID:           image.ID,
+		ProductID:    image.ProductID,
+		ImageObject:  image.ImageObject,
+		Width:        image.Width,
+		Height:       image.Height,
+		Position:     image.Position,
+		IsPrimary:    image.IsPrimary,
+		AltText:      image.AltText,
+		Caption:      image.Caption,
+		Locale:       model.DefaultLocale,
+		Translations: image.Translations,
+	}
+
+	translation, ok := ToImageTranslationMap(image.Translations)[locale]
+	if ok && (translation.AltText != "" || translation.Caption != "") {
+		if translation.AltText != "" {
+			response.AltText = translation.AltText
+		}
+		if translation.Caption != "" {
+			response.Caption = translation.Caption
+		}
+		response.Locale = locale
+	}
+
+	return response
+}
+
+func ToProductImageResponses(images []entity.ProductImage, locale string) []*model.ProductImageResponse {
+	responses := make([]*model.ProductImageResponse, 0, len(images))
+	for i := range images {
+		responses = append(responses, ToProductImageResponse(&images[i], locale))
+	}
+	return responses
+}
+
+func ToImageTranslations(altText, caption string, translations map[string]model.ImageTranslation) datatypes.JSON {
+	merged := make(map[string]model.ImageTranslation, len(translations)+1)
+	for locale, translation := range translations {
+		if translation.AltText != "" || translation.Caption != "" {
+			merged[locale] = translation
+		}
+	}
+	merged[model.DefaultLocale] = model.ImageTranslation{AltText: altText, Caption: caption}
+
+	data, _ := json.Marshal(merged)
+	return datatypes.JSON(data)
+}
+
+func ToImageTranslationMap(translations datatypes.JSON) map[string]model.ImageTranslation {
+	result := map[string]model.ImageTranslation{}
+	if len(translations) > 0 {
+		_ = json.Unmarshal(translations, &result)
+	}
+	return result
+}
@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"time"
+)
+
+func ToPriceCampaignResponse(campaign *entity.PriceCampaign) *model.PriceCampaignResponse {
+	return &model.PriceCampaignResponse{
+		ID:           campaign.ID,
+		Name:         campaign.Name,
+		Scope:        campaign.Scope,
+		TargetID:     campaign.TargetID,
+		DiscountType: campaign.DiscountType,
+		Value:        campaign.Value,
+		Priority:     campaign.Priority,
+		StartsAt:     campaign.StartsAt,
+		EndsAt:       campaign.EndsAt,
+		Active:       campaign.Active,
+		Live:         campaign.IsLive(time.Now()),
+		CreatedBy:    campaign.CreatedBy,
+		CreatedAt:    campaign.CreatedAt,
+		UpdatedAt:    campaign.UpdatedAt,
+	}
+}
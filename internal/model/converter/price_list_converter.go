@@ -0,0 +1,31 @@
+package converter
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+)
+
+func ToPriceListResponse(priceList *entity.PriceList) *model.PriceListResponse {
+	return &model.PriceListResponse{
+		ID:            priceList.ID,
+		Name:          priceList.Name,
+		CustomerGroup: priceList.CustomerGroup,
+		Active:        priceList.Active,
+		CreatedBy:     priceList.CreatedBy,
+		CreatedAt:     priceList.CreatedAt,
+		UpdatedAt:     priceList.UpdatedAt,
+	}
+}
+
+func ToPriceTierResponses(tiers []entity.PriceTier) []*model.PriceTierResponse {
+	responses := make([]*model.PriceTierResponse, 0, len(tiers))
+	for _, tier := range tiers {
+		responses = append(responses, &model.PriceTierResponse{
+			ID:          tier.ID,
+			PriceListID: tier.PriceListID,
+			MinQuantity: tier.MinQuantity,
+			UnitPrice:   tier.UnitPrice,
+		})
+	}
+	return responses
+}
@@ -1,21 +1,148 @@
 package converter
 
 import (
+	"encoding/json"
 	"golectro-product/internal/entity"
 	"golectro-product/internal/model"
+	"golectro-product/internal/utils"
+
+	"gorm.io/datatypes"
 )
 
 func ToProductResponse(product *entity.Product) *model.ProductResponse {
-	return &model.ProductResponse{
-		ID:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Category:    product.Category,
-		Brand:       product.Brand,
-		Color:       product.Color,
-		Specs:       product.Specs,
-		Quantity:    product.Quantity,
-		CreatedBy:   product.CreatedBy,
+	response := &model.ProductResponse{
+		ID:              product.ID,
+		Name:            product.Name,
+		Slug:            product.Slug,
+		Description:     product.Description,
+		Price:           product.Price,
+		Currency:        product.Currency,
+		PriceDroppedAt:  product.PriceDroppedAt,
+		CompareAtPrice:  product.CompareAtPrice,
+		EffectivePrice:  product.EffectivePrice,
+		PriceCampaignID: product.PriceCampaignID,
+		Category:        product.Category,
+		BrandID:         product.BrandID,
+		Brand:           product.Brand,
+		Color:           product.Color,
+		Specs:           product.Specs,
+		Quantity:        product.Quantity,
+		Type:            product.Type,
+		RatingAverage:   product.RatingAverage,
+		RatingCount:     product.RatingCount,
+		Images:          ToProductImageResponses(product.Images, model.DefaultLocale),
+		Status:          product.Status,
+		PublishAt:       product.PublishAt,
+		UnpublishAt:     product.UnpublishAt,
+		CreatedBy:       product.CreatedBy,
+		Categories:      ToCategoryResponses(product.Categories),
+		Locale:          model.DefaultLocale,
+		Translations:    product.Translations,
+	}
+
+	if product.DeletedAt.Valid {
+		deletedAt := product.DeletedAt.Time
+		response.DeletedAt = &deletedAt
+	}
+
+	return response
+}
+
+func ToProductPriceHistoryResponse(product *entity.Product, prices []entity.ProductPrice, lowest *int64) *model.ProductPriceHistoryResponse {
+	responses := make([]*model.ProductPriceResponse, 0, len(prices))
+	for _, price := range prices {
+		responses = append(responses, &model.ProductPriceResponse{
+			Price:         price.Price,
+			EffectiveFrom: price.EffectiveFrom,
+		})
 	}
+
+	return &model.ProductPriceHistoryResponse{
+		ProductID:      product.ID,
+		Price:          product.Price,
+		LowestPrice30d: lowest,
+		PriceDroppedAt: product.PriceDroppedAt,
+		Prices:         responses,
+	}
+}
+
+func ToLocalizedProductResponse(product *entity.Product, locale string) *model.ProductResponse {
+	response := ToProductResponse(product)
+	response.Name, response.Description, response.Locale = localizeProduct(product.Translations, product.Name, product.Description, locale)
+	response.Images = ToProductImageResponses(product.Images, locale)
+	return response
+}
+
+func LocalizeProduct(product *entity.Product, locale string) {
+	product.Name, product.Description, _ = localizeProduct(product.Translations, product.Name, product.Description, locale)
+}
+
+func LocalizeProductDocument(document map[string]any, locale string) {
+	translations, err := json.Marshal(document["translations"])
+	if err != nil {
+		return
+	}
+
+	name, _ := document["name"].(string)
+	description, _ := document["description"].(string)
+	document["name"], document["description"], document["locale"] = localizeProduct(translations, name, description, locale)
+}
+
+func ToProductTranslations(name, description string, translations map[string]model.ProductTranslation) datatypes.JSON {
+	merged := make(map[string]model.ProductTranslation, len(translations)+1)
+	for locale, translation := range translations {
+		if translation.Name != "" || translation.Description != "" {
+			merged[locale] = translation
+		}
+	}
+	merged[model.DefaultLocale] = model.ProductTranslation{Name: name, Description: description}
+
+	data, _ := json.Marshal(merged)
+	return datatypes.JSON(data)
+}
+
+func ToProductTranslationMap(translations datatypes.JSON) map[string]model.ProductTranslation {
+	result := map[string]model.ProductTranslation{}
+	if len(translations) > 0 {
+		_ = json.Unmarshal(translations, &result)
+	}
+	return result
+}
+
+func ToProductStatusTransitionResponse(transition *entity.ProductStatusTransition) *model.ProductStatusTransitionResponse {
+	return &model.ProductStatusTransitionResponse{
+		ID:         transition.ID,
+		FromStatus: transition.FromStatus,
+		ToStatus:   transition.ToStatus,
+		ActorID:    transition.ActorID,
+		Note:       transition.Note,
+		CreatedAt:  transition.CreatedAt,
+	}
+}
+
+func ToSpecLabels(specs []entity.CategorySpec, locale string) map[string]string {
+	labels := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		var values map[string]string
+		_ = json.Unmarshal(spec.Label, &values)
+
+		labels[spec.Key] = utils.PickLocalized(values, locale)
+		if labels[spec.Key] == "" {
+			labels[spec.Key] = spec.Key
+		}
+	}
+	return labels
+}
+
+func localizeProduct(translations datatypes.JSON, name, description, locale string) (string, string, string) {
+	translation, ok := ToProductTranslationMap(translations)[locale]
+	if !ok || translation.Name == "" {
+		return name, description, model.DefaultLocale
+	}
+
+	if translation.Description == "" {
+		translation.Description = description
+	}
+
+	return translation.Name, translation.Description, locale
 }
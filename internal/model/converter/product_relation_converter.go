@@ -0,0 +1,35 @@
+package converter
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+)
+
+func ToProductRelationResponse(relation *entity.ProductRelation) *model.ProductRelationResponse {
+	return &model.ProductRelationResponse{
+		ID:               relation.ID,
+		ProductID:        relation.ProductID,
+		RelatedProductID: relation.RelatedProductID,
+		Type:             relation.Type,
+		Position:         relation.Position,
+		CreatedBy:        relation.CreatedBy,
+		CreatedAt:        relation.CreatedAt,
+	}
+}
+
+func ToRelatedProductResponse(relation *entity.ProductRelation, product *entity.Product) *model.RelatedProductResponse {
+	return &model.RelatedProductResponse{
+		ID:              product.ID,
+		RelationID:      relation.ID,
+		SourceProductID: relation.ProductID,
+		RelationType:    relation.Type,
+		Position:        relation.Position,
+		Name:            product.Name,
+		Slug:            product.Slug,
+		Brand:           product.Brand,
+		Price:           product.Price,
+		EffectivePrice:  product.EffectivePrice,
+		Currency:        product.Currency,
+		Quantity:        product.Quantity,
+	}
+}
@@ -0,0 +1,79 @@
+package converter
+
+import (
+	"encoding/json"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+func ToProductSnapshot(product *entity.Product) datatypes.JSON {
+	categoryIDs := make([]uuid.UUID, 0, len(product.Categories))
+	for _, category := range product.Categories {
+		categoryIDs = append(categoryIDs, category.ID)
+	}
+
+	snapshot := model.ProductSnapshot{
+		Name:           product.Name,
+		Slug:           product.Slug,
+		Description:    product.Description,
+		Translations:   product.Translations,
+		CategoryIDs:    categoryIDs,
+		BrandID:        product.BrandID,
+		Color:          product.Color,
+		Specs:          product.Specs,
+		Price:          product.Price,
+		CompareAtPrice: product.CompareAtPrice,
+		Quantity:       product.Quantity,
+		Status:         product.Status,
+		PublishAt:      product.PublishAt,
+		UnpublishAt:    product.UnpublishAt,
+	}
+	if product.DeletedAt.Valid {
+		snapshot.DeletedAt = &product.DeletedAt.Time
+	}
+
+	data, _ := json.Marshal(snapshot)
+	return datatypes.JSON(data)
+}
+
+// ToRollbackProductRequest builds the update that restores the editable
+// fields of a snapshot. A missing brand or compare-at price is sent as its
+// zero value so that the update clears it instead of leaving the current one.
+func ToRollbackProductRequest(snapshot *model.ProductSnapshot) *model.UpdateProductRequest {
+	brandID := uuid.Nil
+	if snapshot.BrandID != nil {
+		brandID = *snapshot.BrandID
+	}
+	compareAtPrice := int64(0)
+	if snapshot.CompareAtPrice != nil {
+		compareAtPrice = *snapshot.CompareAtPrice
+	}
+
+	return &model.UpdateProductRequest{
+		Name:           &snapshot.Name,
+		Slug:           &snapshot.Slug,
+		Description:    &snapshot.Description,
+		Translations:   ToProductTranslationMap(snapshot.Translations),
+		CategoryIDs:    &snapshot.CategoryIDs,
+		BrandID:        &brandID,
+		Color:          &snapshot.Color,
+		Specs:          &snapshot.Specs,
+		Price:          &snapshot.Price,
+		CompareAtPrice: &compareAtPrice,
+	}
+}
+
+func ToProductRevisionResponse(revision *entity.ProductRevision) *model.ProductRevisionResponse {
+	return &model.ProductRevisionResponse{
+		ID:        revision.ID,
+		Revision:  revision.Revision,
+		ActorID:   revision.ActorID,
+		RequestID: revision.RequestID,
+		Diff:      revision.Diff,
+		Snapshot:  revision.Snapshot,
+		CreatedAt: revision.CreatedAt,
+	}
+}
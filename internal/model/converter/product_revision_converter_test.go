@@ -0,0 +1,242 @@
+package converter
+
+import (
+	"encoding/json"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/utils"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func TestProductSnapshotRoundTrip(t *testing.T) {
+	brandID := uuid.New()
+	compareAtPrice := int64(2_499_900_000)
+	publishAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		product entity.Product
+	}{
+		{
+			name: "full product",
+			product: entity.Product{
+				Name:           "Laptop Pro",
+				Slug:           "laptop-pro",
+				Description:    "A laptop",
+				Translations:   datatypes.JSON(`{"id":{"name":"Laptop Pro","description":"Sebuah laptop"}}`),
+				Categories:     []entity.Category{{ID: uuid.New()}, {ID: uuid.New()}},
+				BrandID:        &brandID,
+				Color:          datatypes.JSON(`["black","silver"]`),
+				Specs:          datatypes.JSON(`{"ram":16,"cpu":"i7"}`),
+				Price:          2_199_900_000,
+				CompareAtPrice: &compareAtPrice,
+				Quantity:       7,
+				Status:         model.ProductStatusInReview,
+				PublishAt:      &publishAt,
+			},
+		},
+		{
+			name: "optional fields empty",
+			product: entity.Product{
+				Name:   "Mouse",
+				Slug:   "mouse",
+				Price:  15_000_000,
+				Status: model.ProductStatusDraft,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var snapshot model.ProductSnapshot
+			if err := json.Unmarshal(ToProductSnapshot(&tt.product), &snapshot); err != nil {
+				t.Fatalf("decode snapshot: %v", err)
+			}
+
+			product := tt.product
+			categoryIDs := make([]uuid.UUID, 0, len(product.Categories))
+			for _, category := range product.Categories {
+				categoryIDs = append(categoryIDs, category.ID)
+			}
+
+			if snapshot.Name != product.Name || snapshot.Slug != product.Slug || snapshot.Description != product.Description {
+				t.Fatalf("snapshot text fields = %q %q %q", snapshot.Name, snapshot.Slug, snapshot.Description)
+			}
+			if !slices.Equal(snapshot.CategoryIDs, categoryIDs) {
+				t.Fatalf("snapshot category IDs = %v, want %v", snapshot.CategoryIDs, categoryIDs)
+			}
+			if !reflect.DeepEqual(snapshot.BrandID, product.BrandID) {
+				t.Fatalf("snapshot brand ID = %v, want %v", snapshot.BrandID, product.BrandID)
+			}
+			if snapshot.Price != product.Price || !reflect.DeepEqual(snapshot.CompareAtPrice, product.CompareAtPrice) {
+				t.Fatalf("snapshot prices = %d %v, want %d %v", snapshot.Price, snapshot.CompareAtPrice, product.Price, product.CompareAtPrice)
+			}
+			if snapshot.Quantity != product.Quantity || snapshot.Status != product.Status {
+				t.Fatalf("snapshot stock = %d %q, want %d %q", snapshot.Quantity, snapshot.Status, product.Quantity, product.Status)
+			}
+			if (snapshot.PublishAt == nil) != (product.PublishAt == nil) || (snapshot.PublishAt != nil && !snapshot.PublishAt.Equal(*product.PublishAt)) {
+				t.Fatalf("snapshot publish at = %v, want %v", snapshot.PublishAt, product.PublishAt)
+			}
+			for field, pair := range map[string][2]datatypes.JSON{
+				"translations": {snapshot.Translations, product.Translations},
+				"color":        {snapshot.Color, product.Color},
+				"specs":        {snapshot.Specs, product.Specs},
+			} {
+				if _, changed := utils.DiffJSON(wrapJSONField(field, pair[0]), wrapJSONField(field, pair[1])); changed {
+					t.Fatalf("snapshot %s = %s, want %s", field, pair[0], pair[1])
+				}
+			}
+		})
+	}
+}
+
+func TestProductSnapshotDiff(t *testing.T) {
+	before := entity.Product{Name: "Laptop", Slug: "laptop", Price: 2_199_900_000, Specs: datatypes.JSON(`{"ram":16,"cpu":"i7"}`)}
+
+	tests := []struct {
+		name   string
+		update func(product *entity.Product)
+		want   []string
+	}{
+		{"no change", func(product *entity.Product) {}, nil},
+		{"price", func(product *entity.Product) { product.Price = 1_999_900_000 }, []string{"price"}},
+		{"specs key order and whitespace", func(product *entity.Product) { product.Specs = datatypes.JSON(`{ "cpu": "i7", "ram": 16 }`) }, nil},
+		{"name and slug", func(product *entity.Product) { product.Name, product.Slug = "Laptop Pro", "laptop-pro" }, []string{"name", "slug"}},
+		{"soft delete", func(product *entity.Product) {
+			product.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		}, []string{"deleted_at"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := before
+			tt.update(&after)
+
+			diff, changed := utils.DiffJSON(ToProductSnapshot(&before), ToProductSnapshot(&after))
+			if changed != (len(tt.want) > 0) {
+				t.Fatalf("DiffJSON() changed = %v, want %v", changed, len(tt.want) > 0)
+			}
+
+			var changes map[string]utils.JSONChange
+			if err := json.Unmarshal(diff, &changes); err != nil {
+				t.Fatalf("decode diff: %v", err)
+			}
+			keys := make([]string, 0, len(changes))
+			for key := range changes {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys)
+			if !slices.Equal(keys, tt.want) {
+				t.Fatalf("changed keys = %v, want %v", keys, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollbackProductRequest(t *testing.T) {
+	brandID, currentBrandID := uuid.New(), uuid.New()
+	compareAtPrice := int64(2_499_900_000)
+	current := entity.Product{
+		Name:           "Laptop Pro 2",
+		Slug:           "laptop-pro-2",
+		Description:    "A newer laptop",
+		Translations:   ToProductTranslations("Laptop Pro 2", "A newer laptop", nil),
+		Categories:     []entity.Category{{ID: uuid.New()}},
+		BrandID:        &currentBrandID,
+		Color:          datatypes.JSON(`["blue"]`),
+		Specs:          datatypes.JSON(`{"ram":32}`),
+		Price:          2_899_900_000,
+		CompareAtPrice: &compareAtPrice,
+		Quantity:       3,
+		Status:         model.ProductStatusPublished,
+	}
+
+	tests := []struct {
+		name   string
+		target entity.Product
+	}{
+		{
+			name: "every editable field",
+			target: entity.Product{
+				Name:           "Laptop Pro",
+				Slug:           "laptop-pro",
+				Description:    "A laptop",
+				Translations:   ToProductTranslations("Laptop Pro", "A laptop", map[string]model.ProductTranslation{"en": {Name: "Laptop Pro", Description: "A portable computer"}}),
+				Categories:     []entity.Category{{ID: uuid.New()}, {ID: uuid.New()}},
+				BrandID:        &brandID,
+				Color:          datatypes.JSON(`["black","silver"]`),
+				Specs:          datatypes.JSON(`{"ram":16,"cpu":"i7"}`),
+				Price:          2_199_900_000,
+				CompareAtPrice: &compareAtPrice,
+			},
+		},
+		{
+			name: "brand and compare-at price cleared",
+			target: entity.Product{
+				Name:         "Laptop",
+				Slug:         "laptop",
+				Translations: ToProductTranslations("Laptop", "", nil),
+				Price:        2_199_900_000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var snapshot model.ProductSnapshot
+			if err := json.Unmarshal(ToProductSnapshot(&tt.target), &snapshot); err != nil {
+				t.Fatalf("decode snapshot: %v", err)
+			}
+
+			restored := current
+			applyUpdateRequest(&restored, ToRollbackProductRequest(&snapshot))
+
+			// Stock and lifecycle state are not part of a rollback.
+			tt.target.Quantity, tt.target.Status = current.Quantity, current.Status
+			diff, changed := utils.DiffJSON(ToProductSnapshot(&tt.target), ToProductSnapshot(&restored))
+			if changed {
+				t.Fatalf("rolled back product differs from the revision: %s", diff)
+			}
+		})
+	}
+}
+
+// applyUpdateRequest sets the fields of an update request the same way
+// ProductUseCase.UpdateProduct does.
+func applyUpdateRequest(product *entity.Product, request *model.UpdateProductRequest) {
+	product.Name = *request.Name
+	product.Slug = *request.Slug
+	product.Description = *request.Description
+	product.Translations = ToProductTranslations(product.Name, product.Description, request.Translations)
+	product.Categories = nil
+	for _, id := range *request.CategoryIDs {
+		product.Categories = append(product.Categories, entity.Category{ID: id})
+	}
+	if request.BrandID != nil {
+		product.BrandID = request.BrandID
+		if *request.BrandID == uuid.Nil {
+			product.BrandID = nil
+		}
+	}
+	product.Color = *request.Color
+	product.Specs = *request.Specs
+	product.Price = *request.Price
+	product.CompareAtPrice = request.CompareAtPrice
+	if *request.CompareAtPrice == 0 {
+		product.CompareAtPrice = nil
+	}
+}
+
+func wrapJSONField(field string, value datatypes.JSON) datatypes.JSON {
+	if len(value) == 0 {
+		value = datatypes.JSON("null")
+	}
+	return datatypes.JSON(`{"` + field + `":` + string(value) + `}`)
+}
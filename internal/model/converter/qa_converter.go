@@ -0,0 +1,56 @@
+package converter
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+)
+
+func ToAnswerResponse(answer *entity.ProductAnswer) *model.AnswerResponse {
+	return &model.AnswerResponse{
+		ID:              answer.ID,
+		QuestionID:      answer.QuestionID,
+		UserID:          answer.UserID,
+		Body:            answer.Body,
+		IsOfficial:      answer.IsOfficial,
+		IsVerifiedBuyer: answer.IsVerifiedBuyer,
+		Status:          answer.Status,
+		ModerationNote:  answer.ModerationNote,
+		ModeratedAt:     answer.ModeratedAt,
+		UpvoteCount:     answer.UpvoteCount,
+		CreatedAt:       answer.CreatedAt,
+		UpdatedAt:       answer.UpdatedAt,
+	}
+}
+
+func ToAnswerResponses(answers []entity.ProductAnswer) []*model.AnswerResponse {
+	responses := make([]*model.AnswerResponse, 0, len(answers))
+	for i := range answers {
+		responses = append(responses, ToAnswerResponse(&answers[i]))
+	}
+	return responses
+}
+
+func ToQuestionResponse(question *entity.ProductQuestion) *model.QuestionResponse {
+	return &model.QuestionResponse{
+		ID:             question.ID,
+		ProductID:      question.ProductID,
+		UserID:         question.UserID,
+		Body:           question.Body,
+		Status:         question.Status,
+		ModerationNote: question.ModerationNote,
+		ModeratedAt:    question.ModeratedAt,
+		UpvoteCount:    question.UpvoteCount,
+		AnswerCount:    question.AnswerCount,
+		Answers:        ToAnswerResponses(question.Answers),
+		CreatedAt:      question.CreatedAt,
+		UpdatedAt:      question.UpdatedAt,
+	}
+}
+
+func ToQuestionResponses(questions []entity.ProductQuestion) []*model.QuestionResponse {
+	responses := make([]*model.QuestionResponse, 0, len(questions))
+	for i := range questions {
+		responses = append(responses, ToQuestionResponse(&questions[i]))
+	}
+	return responses
+}
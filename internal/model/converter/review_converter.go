@@ -0,0 +1,29 @@
+package converter
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+)
+
+func ToReviewResponse(review *entity.ProductReview) *model.ReviewResponse {
+	photos := make([]*model.ReviewPhotoResponse, 0, len(review.Photos))
+	for _, photo := range review.Photos {
+		photos = append(photos, &model.ReviewPhotoResponse{ID: photo.ID})
+	}
+
+	return &model.ReviewResponse{
+		ID:             review.ID,
+		ProductID:      review.ProductID,
+		UserID:         review.UserID,
+		Rating:         review.Rating,
+		Title:          review.Title,
+		Body:           review.Body,
+		Status:         review.Status,
+		ModerationNote: review.ModerationNote,
+		ModeratedAt:    review.ModeratedAt,
+		HelpfulCount:   review.HelpfulCount,
+		Photos:         photos,
+		CreatedAt:      review.CreatedAt,
+		UpdatedAt:      review.UpdatedAt,
+	}
+}
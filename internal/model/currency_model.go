@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	CurrencyIDR  = "IDR"
+	CurrencyUSD  = "USD"
+	CurrencySGD  = "SGD"
+	CurrencyMYR  = "MYR"
+	BaseCurrency = CurrencyIDR
+)
+
+// Converted list prices are rounded to the nearest minor unit. Cash rounding
+// (such as MYR to 5 sen) depends on the tender and is left to checkout.
+type CurrencyFormat struct {
+	Exponent int
+}
+
+var Currencies = map[string]CurrencyFormat{
+	CurrencyIDR: {Exponent: 2},
+	CurrencyUSD: {Exponent: 2},
+	CurrencySGD: {Exponent: 2},
+	CurrencyMYR: {Exponent: 2},
+}
+
+type (
+	Money struct {
+		Amount   int64  `json:"amount"`
+		Currency string `json:"currency"`
+		Exponent int    `json:"exponent"`
+	}
+
+	ProductPricing struct {
+		Currency       string  `json:"currency"`
+		Rate           float64 `json:"rate"`
+		Price          Money   `json:"price"`
+		EffectivePrice Money   `json:"effective_price"`
+		CompareAtPrice *Money  `json:"compare_at_price,omitempty"`
+		LowestPrice30d *Money  `json:"lowest_price_30d,omitempty"`
+		FlashSalePrice *Money  `json:"flash_sale_price,omitempty"`
+	}
+
+	ExchangeRateRequest struct {
+		Currency string  `json:"currency" validate:"required,oneof=USD SGD MYR"`
+		Rate     float64 `json:"rate" validate:"required,gt=0"`
+	}
+
+	UpdateExchangeRatesRequest struct {
+		Rates []ExchangeRateRequest `json:"rates" validate:"required,min=1,unique=Currency,dive"`
+	}
+
+	ExchangeRateResponse struct {
+		BaseCurrency string    `json:"base_currency"`
+		Currency     string    `json:"currency"`
+		Rate         float64   `json:"rate"`
+		UpdatedBy    uuid.UUID `json:"updated_by"`
+		UpdatedAt    time.Time `json:"updated_at"`
+	}
+)
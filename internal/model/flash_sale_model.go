@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FlashSaleClaimSoldOut      = -1
+	FlashSaleClaimLimitReached = -2
+)
+
+type (
+	FlashSaleRequest struct {
+		Name         string    `json:"name" validate:"required,max=150"`
+		ProductID    uuid.UUID `json:"product_id" validate:"required"`
+		SalePrice    int64     `json:"sale_price" validate:"required,gt=0"`
+		Quota        int       `json:"quota" validate:"required,gt=0"`
+		PerUserLimit int       `json:"per_user_limit" validate:"gte=0"`
+		StartsAt     time.Time `json:"starts_at" validate:"required"`
+		EndsAt       time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"`
+		Active       *bool     `json:"active"`
+	}
+
+	FlashSaleResponse struct {
+		ID           uuid.UUID `json:"id"`
+		Name         string    `json:"name"`
+		ProductID    uuid.UUID `json:"product_id"`
+		SalePrice    int64     `json:"sale_price"`
+		Quota        int       `json:"quota"`
+		Sold         int       `json:"sold"`
+		Remaining    int       `json:"remaining"`
+		PerUserLimit int       `json:"per_user_limit"`
+		StartsAt     time.Time `json:"starts_at"`
+		EndsAt       time.Time `json:"ends_at"`
+		Active       bool      `json:"active"`
+		Live         bool      `json:"live"`
+		CreatedBy    uuid.UUID `json:"created_by"`
+		CreatedAt    time.Time `json:"created_at"`
+		UpdatedAt    time.Time `json:"updated_at"`
+	}
+
+	FlashSaleSummary struct {
+		ID           uuid.UUID `json:"id"`
+		SalePrice    int64     `json:"sale_price"`
+		Remaining    int       `json:"remaining"`
+		PerUserLimit int       `json:"per_user_limit"`
+		EndsAt       time.Time `json:"ends_at"`
+	}
+
+	DecreaseQuantityResult struct {
+		NewQuantity int32
+		UnitPrice   int64
+		Currency    string
+		FlashSaleID *uuid.UUID
+	}
+)
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+const (
+	ImageSizeOriginal = "original"
+	ImageSizeThumb    = "thumb"
+	ImageSizeMedium   = "medium"
+	ImageSizeLarge    = "large"
+)
+
+const (
+	ImageFormatOriginal = "original"
+	ImageFormatWebP     = "webp"
+)
+
+const ImageCacheMaxAge = 365 * 24 * 60 * 60
+
+const ImageRenditionPrefix = "renditions/"
+
+const ImageGCGracePeriod = 24 * time.Hour
+
+const (
+	ImageUploadStatusPending   = "pending"
+	ImageUploadStatusConfirmed = "confirmed"
+	ImageUploadStatusRejected  = "rejected"
+)
+
+const (
+	ImageUploadMaxFiles      = 5
+	ImageUploadMaxFileSizeMB = 5
+	ImageUploadURLExpiry     = 15 * time.Minute
+)
+
+const (
+	ProductImageMinDimension   = 300
+	ProductImageMaxDimension   = 6000
+	ProductImageMinAspectRatio = 0.5
+	ProductImageMaxAspectRatio = 2.0
+)
+
+var ImageUploadAllowedTypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+var ImageUploadExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+var ImageRenditionSizes = map[string]int{
+	ImageSizeThumb:  150,
+	ImageSizeMedium: 600,
+	ImageSizeLarge:  1200,
+}
+
+var ImageRenditionFormats = []string{ImageFormatOriginal, ImageFormatWebP}
+
+const ImageNearDuplicateDistance = 6
+
+type UploadedImage struct {
+	ObjectKey      string
+	Width          int
+	Height         int
+	ContentHash    string
+	PerceptualHash *uint64
+}
+
+type ImagePreviewRequest struct {
+	Size   string `form:"size" validate:"omitempty,oneof=original thumb medium large"`
+	Format string `form:"format" validate:"omitempty,oneof=original webp"`
+}
+
+type ImageRendition struct {
+	ObjectKey    string
+	ContentType  string
+	Size         int64
+	ETag         string
+	LastModified time.Time
+}
+
+type (
+	ImageTranslation struct {
+		AltText string `json:"alt_text" validate:"max=255"`
+		Caption string `json:"caption" validate:"max=500"`
+	}
+
+	ProductImageRequest struct {
+		AltText      string                      `json:"alt_text" validate:"max=255"`
+		Caption      string                      `json:"caption" validate:"max=500"`
+		Translations map[string]ImageTranslation `json:"translations" validate:"omitempty,dive,keys,oneof=en id,endkeys"`
+		IsPrimary    bool                        `json:"is_primary"`
+	}
+
+	ImageOrderRequest struct {
+		ImageIDs       []uuid.UUID `json:"image_ids" validate:"required,min=1,unique,dive,required"`
+		PrimaryImageID *uuid.UUID  `json:"primary_image_id"`
+	}
+
+	ProductImageResponse struct {
+		ID           uuid.UUID      `json:"id"`
+		ProductID    uuid.UUID      `json:"product_id"`
+		ImageObject  string         `json:"image_object"`
+		URL          string         `json:"url,omitempty"`
+		Width        int            `json:"width"`
+		Height       int            `json:"height"`
+		Position     int            `json:"position"`
+		IsPrimary    bool           `json:"is_primary"`
+		AltText      string         `json:"alt_text"`
+		Caption      string         `json:"caption"`
+		Locale       string         `json:"locale"`
+		Translations datatypes.JSON `json:"translations"`
+	}
+
+	ImageUploadFileRequest struct {
+		FileName       string `json:"file_name" validate:"required,max=255"`
+		ContentType    string `json:"content_type" validate:"required,oneof=image/jpeg image/png image/gif"`
+		Size           int64  `json:"size" validate:"required,gt=0,lte=5242880"`
+		ChecksumSHA256 string `json:"checksum_sha256" validate:"required,len=64,hexadecimal"`
+	}
+
+	ImageUploadURLRequest struct {
+		Files []ImageUploadFileRequest `json:"files" validate:"required,min=1,max=5,dive"`
+	}
+
+	ImageUploadURLResponse struct {
+		UploadID  uuid.UUID         `json:"upload_id"`
+		ObjectKey string            `json:"object_key"`
+		UploadURL string            `json:"upload_url"`
+		Method    string            `json:"method"`
+		Headers   map[string]string `json:"headers"`
+		ExpiresAt time.Time         `json:"expires_at"`
+	}
+
+	ImageUploadConfirmRequest struct {
+		UploadIDs []uuid.UUID `json:"upload_ids" validate:"required,min=1,max=5,unique"`
+	}
+
+	ImageUploadConfirmResponse struct {
+		Images   []*ProductImageResponse  `json:"images"`
+		Warnings []*ImageDuplicateWarning `json:"warnings,omitempty"`
+	}
+
+	ImageDuplicateWarning struct {
+		ImageID            uuid.UUID `json:"image_id"`
+		ObjectKey          string    `json:"object_key"`
+		DuplicateImageID   uuid.UUID `json:"duplicate_image_id"`
+		DuplicateProductID uuid.UUID `json:"duplicate_product_id"`
+		Distance           int       `json:"distance"`
+	}
+
+	ImageDuplicateGroup struct {
+		ID          uuid.UUID               `json:"id"`
+		MaxDistance int                     `json:"max_distance"`
+		Images      []*ProductImageResponse `json:"images"`
+	}
+)
@@ -0,0 +1,9 @@
+package model
+
+const (
+	LocaleEN      = "en"
+	LocaleID      = "id"
+	DefaultLocale = LocaleID
+)
+
+var SupportedLocales = []string{LocaleEN, LocaleID}
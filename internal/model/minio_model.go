@@ -7,6 +7,7 @@ type UploadFileInput struct {
 	ObjectKey   string
 	Content     []byte
 	ContentType string
+	Metadata    map[string]string
 }
 
 type PresignedURLInput struct {
@@ -24,6 +25,11 @@ type DeleteFileRequest struct {
 	ObjectKey string `json:"object_key" validate:"required"`
 }
 
+type ScanResult struct {
+	Clean     bool
+	Signature string
+}
+
 type MinioObjectResponse struct {
 	Bucket    string
 	ObjectKey string
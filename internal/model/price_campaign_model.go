@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PriceCampaignScopeProduct  = "product"
+	PriceCampaignScopeCategory = "category"
+	PriceCampaignScopeBrand    = "brand"
+)
+
+const (
+	DiscountTypePercentage = "percentage"
+	DiscountTypeFixed      = "fixed"
+	DiscountTypeSalePrice  = "sale_price"
+)
+
+// PercentageDiscountMax is 100% in basis points; percentage campaign values
+// are basis points, fixed and sale_price values are minor currency units.
+const PercentageDiscountMax = 10000
+
+type (
+	PriceCampaignRequest struct {
+		Name         string    `json:"name" validate:"required,max=150"`
+		Scope        string    `json:"scope" validate:"required,oneof=product category brand"`
+		TargetID     uuid.UUID `json:"target_id" validate:"required"`
+		DiscountType string    `json:"discount_type" validate:"required,oneof=percentage fixed sale_price"`
+		Value        int64     `json:"value" validate:"required,gt=0"`
+		Priority     int       `json:"priority"`
+		StartsAt     time.Time `json:"starts_at" validate:"required"`
+		EndsAt       time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"`
+		Active       *bool     `json:"active"`
+	}
+
+	PriceCampaignResponse struct {
+		ID           uuid.UUID `json:"id"`
+		Name         string    `json:"name"`
+		Scope        string    `json:"scope"`
+		TargetID     uuid.UUID `json:"target_id"`
+		DiscountType string    `json:"discount_type"`
+		Value        int64     `json:"value"`
+		Priority     int       `json:"priority"`
+		StartsAt     time.Time `json:"starts_at"`
+		EndsAt       time.Time `json:"ends_at"`
+		Active       bool      `json:"active"`
+		Live         bool      `json:"live"`
+		CreatedBy    uuid.UUID `json:"created_by"`
+		CreatedAt    time.Time `json:"created_at"`
+		UpdatedAt    time.Time `json:"updated_at"`
+	}
+)
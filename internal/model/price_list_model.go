@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PriceSourceBase      = "base"
+	PriceSourceCampaign  = "campaign"
+	PriceSourceTier      = "tier"
+	PriceSourcePriceList = "price_list"
+)
+
+const (
+	CustomerGroupClaim      = "customer_group"
+	CustomerGroupPathPrefix = "/customer-groups/"
+	CustomerGroupRolePrefix = "customer_group:"
+)
+
+type (
+	PriceListRequest struct {
+		Name          string `json:"name" validate:"required,max=150"`
+		CustomerGroup string `json:"customer_group" validate:"required,max=50"`
+		Active        *bool  `json:"active"`
+	}
+
+	PriceListResponse struct {
+		ID            uuid.UUID `json:"id"`
+		Name          string    `json:"name"`
+		CustomerGroup string    `json:"customer_group"`
+		Active        bool      `json:"active"`
+		CreatedBy     uuid.UUID `json:"created_by"`
+		CreatedAt     time.Time `json:"created_at"`
+		UpdatedAt     time.Time `json:"updated_at"`
+	}
+
+	PriceTierRequest struct {
+		MinQuantity int   `json:"min_quantity" validate:"required,gte=1"`
+		UnitPrice   int64 `json:"unit_price" validate:"required,gt=0"`
+	}
+
+	ProductPriceTiersRequest struct {
+		PriceListID *uuid.UUID         `json:"price_list_id"`
+		Tiers       []PriceTierRequest `json:"tiers" validate:"omitempty,unique=MinQuantity,dive"`
+	}
+
+	PriceTierResponse struct {
+		ID          uuid.UUID  `json:"id"`
+		PriceListID *uuid.UUID `json:"price_list_id,omitempty"`
+		MinQuantity int        `json:"min_quantity"`
+		UnitPrice   int64      `json:"unit_price"`
+	}
+
+	PriceQuoteResponse struct {
+		ProductID       uuid.UUID  `json:"product_id"`
+		Quantity        int        `json:"quantity"`
+		CustomerGroup   string     `json:"customer_group,omitempty"`
+		Currency        string     `json:"currency"`
+		ListPrice       int64      `json:"list_price"`
+		UnitPrice       int64      `json:"unit_price"`
+		Total           int64      `json:"total"`
+		Source          string     `json:"source"`
+		PriceCampaignID *uuid.UUID `json:"price_campaign_id,omitempty"`
+		PriceListID     *uuid.UUID `json:"price_list_id,omitempty"`
+		PriceTierID     *uuid.UUID `json:"price_tier_id,omitempty"`
+	}
+)
@@ -1,62 +1,153 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/datatypes"
 )
 
+const (
+	ProductStatusDraft     = "draft"
+	ProductStatusInReview  = "in_review"
+	ProductStatusPublished = "published"
+	ProductStatusArchived  = "archived"
+)
+
+const LowestPriceWindowDays = 30
+
+var ProductStatusTransitions = map[string][]string{
+	ProductStatusDraft:     {ProductStatusInReview, ProductStatusArchived},
+	ProductStatusInReview:  {ProductStatusDraft, ProductStatusPublished},
+	ProductStatusPublished: {ProductStatusArchived},
+	ProductStatusArchived:  {ProductStatusDraft},
+}
+
 type (
+	ProductTranslation struct {
+		Name        string `json:"name" validate:"max=255"`
+		Description string `json:"description" validate:"max=2000"`
+	}
+
 	ProductRequest struct {
-		Name        string         `json:"name" validate:"required,max=255"`
-		Description string         `json:"description" validate:"max=2000"`
-		Category    datatypes.JSON `json:"category"`
-		Brand       string         `json:"brand" validate:"required,max=100"`
-		Color       datatypes.JSON `json:"color"`
-		Specs       datatypes.JSON `json:"specs"`
-		Price       float64        `json:"price" validate:"required"`
-		Quantity    int            `json:"quantity" validate:"required,gte=0"`
+		Name           string                        `json:"name" validate:"required,max=255"`
+		Slug           string                        `json:"slug" validate:"omitempty,max=191"`
+		Description    string                        `json:"description" validate:"max=2000"`
+		Translations   map[string]ProductTranslation `json:"translations" validate:"omitempty,dive,keys,oneof=en id,endkeys"`
+		CategoryIDs    []uuid.UUID                   `json:"category_ids" validate:"omitempty,dive,required"`
+		BrandID        uuid.UUID                     `json:"brand_id" validate:"required"`
+		Color          datatypes.JSON                `json:"color"`
+		Specs          datatypes.JSON                `json:"specs"`
+		Price          int64                         `json:"price" validate:"required"`
+		CompareAtPrice *int64                        `json:"compare_at_price" validate:"omitempty,gt=0"`
+		Quantity       int                           `json:"quantity" validate:"required_unless=Type bundle,gte=0"`
+		Type           string                        `json:"type" validate:"omitempty,oneof=simple bundle"`
+		Components     []BundleComponentRequest      `json:"components" validate:"required_if=Type bundle,unique=ComponentID,dive"`
 	}
 
 	ProductResponse struct {
-		ID          uuid.UUID      `json:"id"`
-		Name        string         `json:"name"`
-		Description string         `json:"description"`
-		Category    datatypes.JSON `json:"category"`
-		Brand       string         `json:"brand"`
-		Color       datatypes.JSON `json:"color"`
-		Specs       datatypes.JSON `json:"specs"`
-		Price       float64        `json:"price"`
-		Quantity    int            `json:"quantity"`
-		CreatedBy   uuid.UUID      `json:"created_by"`
+		ID              uuid.UUID                            `json:"id"`
+		Name            string                               `json:"name"`
+		Slug            string                               `json:"slug"`
+		Description     string                               `json:"description"`
+		Category        datatypes.JSON                       `json:"category"`
+		BrandID         *uuid.UUID                           `json:"brand_id"`
+		Brand           string                               `json:"brand"`
+		Color           datatypes.JSON                       `json:"color"`
+		Specs           datatypes.JSON                       `json:"specs"`
+		Price           int64                                `json:"price"`
+		Currency        string                               `json:"currency"`
+		CompareAtPrice  *int64                               `json:"compare_at_price"`
+		EffectivePrice  int64                                `json:"effective_price"`
+		PriceCampaignID *uuid.UUID                           `json:"price_campaign_id,omitempty"`
+		FlashSale       *FlashSaleSummary                    `json:"flash_sale,omitempty"`
+		Quantity        int                                  `json:"quantity"`
+		Type            string                               `json:"type"`
+		RatingAverage   float64                              `json:"rating_average"`
+		RatingCount     int                                  `json:"rating_count"`
+		Images          []*ProductImageResponse              `json:"images"`
+		Components      []*BundleComponentResponse           `json:"components,omitempty"`
+		Status          string                               `json:"status"`
+		PublishAt       *time.Time                           `json:"publish_at"`
+		UnpublishAt     *time.Time                           `json:"unpublish_at"`
+		DeletedAt       *time.Time                           `json:"deleted_at,omitempty"`
+		CreatedBy       uuid.UUID                            `json:"created_by"`
+		Categories      []*CategoryResponse                  `json:"categories"`
+		Locale          string                               `json:"locale"`
+		Translations    datatypes.JSON                       `json:"translations"`
+		SpecLabels      map[string]string                    `json:"spec_labels,omitempty"`
+		LowestPrice30d  *int64                               `json:"lowest_price_30d,omitempty"`
+		PriceDroppedAt  *time.Time                           `json:"price_dropped_at,omitempty"`
+		Pricing         *ProductPricing                      `json:"pricing,omitempty"`
+		PriceTiers      []*PriceTierResponse                 `json:"price_tiers,omitempty"`
+		Related         map[string][]*RelatedProductResponse `json:"related,omitempty"`
 	}
 
 	SearchProductsRequest struct {
-		Page     *int              `form:"page" validate:"omitempty,min=1"`
-		Limit    *int              `form:"limit" validate:"omitempty,min=1"`
-		Price    *string           `form:"price" validate:"omitempty,oneof=asc desc"`
-		Name     *string           `form:"name" validate:"omitempty,max=255"`
-		Category []string          `form:"category" validate:"omitempty,max=255"`
-		Brand    []string          `form:"brand" validate:"omitempty,max=255"`
-		Color    []string          `form:"color" validate:"omitempty,max=255"`
-		MinPrice *float64          `form:"min_price" validate:"omitempty,gte=0"`
-		MaxPrice *float64          `form:"max_price" validate:"omitempty,gte=0"`
-		Specs    map[string]string `form:"specs" validate:"omitempty"`
+		Page         *int              `form:"page" validate:"omitempty,min=1"`
+		Limit        *int              `form:"limit" validate:"omitempty,min=1"`
+		Price        *string           `form:"price" validate:"omitempty,oneof=asc desc"`
+		Name         *string           `form:"name" validate:"omitempty,max=255"`
+		Category     []string          `form:"category" validate:"omitempty,max=255"`
+		Brand        []string          `form:"brand" validate:"omitempty,max=255"`
+		Color        []string          `form:"color" validate:"omitempty,max=255"`
+		MinPrice     *float64          `form:"min_price" validate:"omitempty,gte=0"`
+		MaxPrice     *float64          `form:"max_price" validate:"omitempty,gte=0"`
+		PriceDropped *bool             `form:"price_dropped"`
+		MinRating    *float64          `form:"min_rating" validate:"omitempty,gte=0,lte=5"`
+		Specs        map[string]string `form:"specs" validate:"omitempty"`
 	}
 
 	UpdateProductRequest struct {
-		Name        *string         `json:"name,omitempty" validate:"max=255"`
-		Description *string         `json:"description,omitempty" validate:"max=2000"`
-		Category    *datatypes.JSON `json:"category,omitempty"`
-		Brand       *string         `json:"brand,omitempty" validate:"max=100"`
-		Color       *datatypes.JSON `json:"color,omitempty"`
-		Specs       *datatypes.JSON `json:"specs,omitempty"`
-		Price       *float64        `json:"price,omitempty"`
-		Quantity    *int            `json:"quantity,omitempty" validate:"omitempty,gte=0"`
+		Name           *string                       `json:"name,omitempty" validate:"max=255"`
+		Slug           *string                       `json:"slug,omitempty" validate:"omitempty,max=191"`
+		Description    *string                       `json:"description,omitempty" validate:"max=2000"`
+		Translations   map[string]ProductTranslation `json:"translations,omitempty" validate:"omitempty,dive,keys,oneof=en id,endkeys"`
+		CategoryIDs    *[]uuid.UUID                  `json:"category_ids,omitempty" validate:"omitempty,dive,required"`
+		BrandID        *uuid.UUID                    `json:"brand_id,omitempty"`
+		Color          *datatypes.JSON               `json:"color,omitempty"`
+		Specs          *datatypes.JSON               `json:"specs,omitempty"`
+		Price          *int64                        `json:"price,omitempty"`
+		CompareAtPrice *int64                        `json:"compare_at_price,omitempty" validate:"omitempty,gte=0"`
+		Quantity       *int                          `json:"quantity,omitempty" validate:"omitempty,gte=0"`
+	}
+
+	ProductStatusRequest struct {
+		Status string `json:"status" validate:"required,oneof=draft in_review published archived"`
+		Note   string `json:"note" validate:"max=500"`
+	}
+
+	ProductScheduleRequest struct {
+		PublishAt   *time.Time `json:"publish_at"`
+		UnpublishAt *time.Time `json:"unpublish_at"`
+	}
+
+	ProductPriceResponse struct {
+		Price         int64     `json:"price"`
+		EffectiveFrom time.Time `json:"effective_from"`
+	}
+
+	ProductPriceHistoryResponse struct {
+		ProductID      uuid.UUID               `json:"product_id"`
+		Price          int64                   `json:"price"`
+		LowestPrice30d *int64                  `json:"lowest_price_30d"`
+		PriceDroppedAt *time.Time              `json:"price_dropped_at"`
+		Prices         []*ProductPriceResponse `json:"prices"`
+	}
+
+	ProductStatusTransitionResponse struct {
+		ID         uuid.UUID `json:"id"`
+		FromStatus string    `json:"from_status"`
+		ToStatus   string    `json:"to_status"`
+		ActorID    uuid.UUID `json:"actor_id"`
+		Note       string    `json:"note"`
+		CreatedAt  time.Time `json:"created_at"`
 	}
 
 	UploadFilesResponse struct {
-		ProductID uuid.UUID `json:"product_id"`
-		Images    []string  `json:"images"`
+		ProductID uuid.UUID                `json:"product_id"`
+		Images    []string                 `json:"images"`
+		Warnings  []*ImageDuplicateWarning `json:"warnings,omitempty"`
 	}
 
 	ProductImageURLResponse struct {
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ProductRelationAccessory      = "accessory"
+	ProductRelationCompatibleWith = "compatible_with"
+	ProductRelationCrossSell      = "cross_sell"
+	ProductRelationUpSell         = "up_sell"
+	ProductRelationReplacement    = "replacement"
+)
+
+var ProductRelationTypes = []string{
+	ProductRelationAccessory,
+	ProductRelationCompatibleWith,
+	ProductRelationCrossSell,
+	ProductRelationUpSell,
+	ProductRelationReplacement,
+}
+
+var ProductRelationInverses = map[string]string{
+	ProductRelationAccessory:      ProductRelationCompatibleWith,
+	ProductRelationCompatibleWith: ProductRelationAccessory,
+}
+
+type (
+	ProductRelationRequest struct {
+		RelatedProductID uuid.UUID `json:"related_product_id" validate:"required"`
+		Type             string    `json:"type" validate:"required,oneof=accessory compatible_with cross_sell up_sell replacement"`
+		Position         int       `json:"position" validate:"gte=0"`
+	}
+
+	ProductRelationResponse struct {
+		ID               uuid.UUID `json:"id"`
+		ProductID        uuid.UUID `json:"product_id"`
+		RelatedProductID uuid.UUID `json:"related_product_id"`
+		Type             string    `json:"type"`
+		Position         int       `json:"position"`
+		CreatedBy        uuid.UUID `json:"created_by"`
+		CreatedAt        time.Time `json:"created_at"`
+	}
+
+	RelatedProductResponse struct {
+		ID              uuid.UUID `json:"id"`
+		RelationID      uuid.UUID `json:"relation_id"`
+		SourceProductID uuid.UUID `json:"source_product_id"`
+		RelationType    string    `json:"relation_type"`
+		Position        int       `json:"position"`
+		Name            string    `json:"name"`
+		Slug            string    `json:"slug"`
+		Brand           string    `json:"brand"`
+		Price           int64     `json:"price"`
+		EffectivePrice  int64     `json:"effective_price"`
+		Currency        string    `json:"currency"`
+		Quantity        int       `json:"quantity"`
+	}
+)
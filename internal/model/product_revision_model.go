@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+type (
+	ProductSnapshot struct {
+		Name           string         `json:"name"`
+		Slug           string         `json:"slug"`
+		Description    string         `json:"description"`
+		Translations   datatypes.JSON `json:"translations"`
+		CategoryIDs    []uuid.UUID    `json:"category_ids"`
+		BrandID        *uuid.UUID     `json:"brand_id"`
+		Color          datatypes.JSON `json:"color"`
+		Specs          datatypes.JSON `json:"specs"`
+		Price          int64          `json:"price"`
+		CompareAtPrice *int64         `json:"compare_at_price"`
+		Quantity       int            `json:"quantity"`
+		Status         string         `json:"status"`
+		PublishAt      *time.Time     `json:"publish_at"`
+		UnpublishAt    *time.Time     `json:"unpublish_at"`
+		DeletedAt      *time.Time     `json:"deleted_at,omitempty"`
+	}
+
+	ProductRevisionResponse struct {
+		ID        uuid.UUID      `json:"id"`
+		Revision  int            `json:"revision"`
+		ActorID   uuid.UUID      `json:"actor_id"`
+		RequestID string         `json:"request_id"`
+		Diff      datatypes.JSON `json:"diff"`
+		Snapshot  datatypes.JSON `json:"snapshot"`
+		CreatedAt time.Time      `json:"created_at"`
+	}
+
+	ProductRevisionDiffResponse struct {
+		From int            `json:"from"`
+		To   int            `json:"to"`
+		Diff datatypes.JSON `json:"diff"`
+	}
+)
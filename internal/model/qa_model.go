@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	QAStatusPending  = "pending"
+	QAStatusApproved = "approved"
+	QAStatusRejected = "rejected"
+)
+
+const (
+	QATargetQuestion = "question"
+	QATargetAnswer   = "answer"
+)
+
+const (
+	QuestionSortRecent = "recent"
+	QuestionSortVotes  = "votes"
+)
+
+type (
+	QuestionRequest struct {
+		Body string `json:"body" validate:"required,min=5,max=1000"`
+	}
+
+	AnswerRequest struct {
+		Body string `json:"body" validate:"required,min=2,max=2000"`
+	}
+
+	QAModerationRequest struct {
+		Status string `json:"status" validate:"required,oneof=approved rejected"`
+		Note   string `json:"note" validate:"max=500"`
+	}
+
+	AnswerResponse struct {
+		ID              uuid.UUID  `json:"id"`
+		QuestionID      uuid.UUID  `json:"question_id"`
+		UserID          uuid.UUID  `json:"user_id"`
+		Body            string     `json:"body"`
+		IsOfficial      bool       `json:"is_official"`
+		IsVerifiedBuyer bool       `json:"is_verified_buyer"`
+		Status          string     `json:"status"`
+		ModerationNote  string     `json:"moderation_note,omitempty"`
+		ModeratedAt     *time.Time `json:"moderated_at,omitempty"`
+		UpvoteCount     int        `json:"upvote_count"`
+		CreatedAt       time.Time  `json:"created_at"`
+		UpdatedAt       time.Time  `json:"updated_at"`
+	}
+
+	QuestionResponse struct {
+		ID             uuid.UUID         `json:"id"`
+		ProductID      uuid.UUID         `json:"product_id"`
+		UserID         uuid.UUID         `json:"user_id"`
+		Body           string            `json:"body"`
+		Status         string            `json:"status"`
+		ModerationNote string            `json:"moderation_note,omitempty"`
+		ModeratedAt    *time.Time        `json:"moderated_at,omitempty"`
+		UpvoteCount    int               `json:"upvote_count"`
+		AnswerCount    int               `json:"answer_count"`
+		Answers        []*AnswerResponse `json:"answers"`
+		CreatedAt      time.Time         `json:"created_at"`
+		UpdatedAt      time.Time         `json:"updated_at"`
+	}
+)
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ReviewStatusPending  = "pending"
+	ReviewStatusApproved = "approved"
+	ReviewStatusRejected = "rejected"
+)
+
+const (
+	ReviewSortRecent  = "recent"
+	ReviewSortHelpful = "helpful"
+	ReviewSortHighest = "highest"
+	ReviewSortLowest  = "lowest"
+)
+
+const ReviewMaxPhotos = 5
+
+type (
+	ReviewRequest struct {
+		Rating int    `json:"rating" validate:"required,min=1,max=5"`
+		Title  string `json:"title" validate:"max=150"`
+		Body   string `json:"body" validate:"required,max=2000"`
+	}
+
+	ReviewModerationRequest struct {
+		Status string `json:"status" validate:"required,oneof=approved rejected"`
+		Note   string `json:"note" validate:"max=500"`
+	}
+
+	ReviewPhotoResponse struct {
+		ID  uuid.UUID `json:"id"`
+		URL string    `json:"url"`
+	}
+
+	ReviewResponse struct {
+		ID             uuid.UUID              `json:"id"`
+		ProductID      uuid.UUID              `json:"product_id"`
+		UserID         uuid.UUID              `json:"user_id"`
+		Rating         int                    `json:"rating"`
+		Title          string                 `json:"title"`
+		Body           string                 `json:"body"`
+		Status         string                 `json:"status"`
+		ModerationNote string                 `json:"moderation_note,omitempty"`
+		ModeratedAt    *time.Time             `json:"moderated_at,omitempty"`
+		HelpfulCount   int                    `json:"helpful_count"`
+		Photos         []*ReviewPhotoResponse `json:"photos"`
+		CreatedAt      time.Time              `json:"created_at"`
+		UpdatedAt      time.Time              `json:"updated_at"`
+	}
+
+	RatingSummaryResponse struct {
+		ProductID     uuid.UUID     `json:"product_id"`
+		RatingAverage float64       `json:"rating_average"`
+		RatingCount   int64         `json:"rating_count"`
+		Distribution  map[int]int64 `json:"distribution"`
+	}
+)
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type BrandRepository struct {
+	Repository[entity.Brand]
+	Log *logrus.Logger
+}
+
+func NewBrandRepository(log *logrus.Logger) *BrandRepository {
+	return &BrandRepository{Log: log}
+}
+
+func (r *BrandRepository) GetAll(db *gorm.DB) ([]entity.Brand, error) {
+	var brands []entity.Brand
+
+	if err := db.Order("name ASC").Find(&brands).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find brands")
+		return nil, err
+	}
+
+	return brands, nil
+}
+
+func (r *BrandRepository) CountProductsByBrand(db *gorm.DB) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		BrandID uuid.UUID
+		Total   int64
+	}
+
+	err := db.Model(&entity.Product{}).
+		Select("brand_id, COUNT(*) AS total").
+		Where("brand_id IS NOT NULL").
+		Group("brand_id").
+		Scan(&rows).Error
+	if err != nil {
+		r.Log.WithError(err).Error("Failed to count products by brand")
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		counts[row.BrandID] = row.Total
+	}
+
+	return counts, nil
+}
+
+func (r *BrandRepository) FindBrandById(db *gorm.DB, brandID uuid.UUID) (*entity.Brand, error) {
+	var brand entity.Brand
+
+	if err := db.First(&brand, "id = ?", brandID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &brand, nil
+}
+
+func (r *BrandRepository) FindBrandBySlug(db *gorm.DB, slug string) (*entity.Brand, error) {
+	var brand entity.Brand
+
+	if err := db.First(&brand, "slug = ?", slug).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &brand, nil
+}
+
+func (r *BrandRepository) CountBySlug(db *gorm.DB, slug string, excludeID uuid.UUID) (int64, error) {
+	var total int64
+	err := db.Model(&entity.Brand{}).Where("slug = ? AND id <> ?", slug, excludeID).Count(&total).Error
+	return total, err
+}
+
+func (r *BrandRepository) CountProducts(db *gorm.DB, brandID uuid.UUID) (int64, error) {
+	var total int64
+	err := db.Model(&entity.Product{}).Where("brand_id = ?", brandID).Count(&total).Error
+	return total, err
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type BundleComponentRepository struct {
+	Repository[entity.BundleComponent]
+	Log *logrus.Logger
+}
+
+func NewBundleComponentRepository(log *logrus.Logger) *BundleComponentRepository {
+	return &BundleComponentRepository{Log: log}
+}
+
+func (r *BundleComponentRepository) FindByBundleIds(db *gorm.DB, bundleIDs []uuid.UUID) ([]entity.BundleComponent, error) {
+	var components []entity.BundleComponent
+
+	if err := db.Where("bundle_id IN ?", bundleIDs).Order("created_at ASC").Find(&components).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find bundle components")
+		return nil, err
+	}
+
+	return components, nil
+}
+
+func (r *BundleComponentRepository) FindBundleIdsByComponentIds(db *gorm.DB, componentIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var bundleIDs []uuid.UUID
+
+	if err := db.Model(&entity.BundleComponent{}).
+		Where("component_id IN ?", componentIDs).
+		Distinct().
+		Pluck("bundle_id", &bundleIDs).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find bundles by component IDs")
+		return nil, err
+	}
+
+	return bundleIDs, nil
+}
+
+func (r *BundleComponentRepository) CountActiveBundlesByComponentId(db *gorm.DB, componentID uuid.UUID) (int64, error) {
+	var total int64
+
+	err := db.Model(&entity.BundleComponent{}).
+		Joins("JOIN products ON products.id = bundle_components.bundle_id AND products.deleted_at IS NULL").
+		Where("bundle_components.component_id = ?", componentID).
+		Count(&total).Error
+
+	return total, err
+}
+
+func (r *BundleComponentRepository) Replace(db *gorm.DB, bundleID uuid.UUID, components []entity.BundleComponent) error {
+	if err := db.Where("bundle_id = ?", bundleID).Delete(&entity.BundleComponent{}).Error; err != nil {
+		return err
+	}
+
+	if len(components) == 0 {
+		return nil
+	}
+
+	return db.Omit("Bundle", "Component").Create(&components).Error
+}
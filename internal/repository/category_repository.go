@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CategoryRepository struct {
+	Repository[entity.Category]
+	Log *logrus.Logger
+}
+
+func NewCategoryRepository(log *logrus.Logger) *CategoryRepository {
+	return &CategoryRepository{Log: log}
+}
+
+func (r *CategoryRepository) GetAll(db *gorm.DB) ([]entity.Category, error) {
+	var categories []entity.Category
+
+	if err := db.Order("slug ASC").Find(&categories).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find categories")
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) FindCategoryById(db *gorm.DB, categoryID uuid.UUID) (*entity.Category, error) {
+	var category entity.Category
+
+	if err := db.First(&category, "id = ?", categoryID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (r *CategoryRepository) FindCategoriesByIds(db *gorm.DB, categoryIDs []uuid.UUID) ([]entity.Category, error) {
+	var categories []entity.Category
+
+	if err := db.Where("id IN ?", categoryIDs).Find(&categories).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find categories by IDs")
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) CountBySlug(db *gorm.DB, slug string, excludeID uuid.UUID) (int64, error) {
+	var total int64
+	err := db.Model(&entity.Category{}).Where("slug = ? AND id <> ?", slug, excludeID).Count(&total).Error
+	return total, err
+}
+
+func (r *CategoryRepository) CountChildren(db *gorm.DB, categoryID uuid.UUID) (int64, error) {
+	var total int64
+	err := db.Model(&entity.Category{}).Where("parent_id = ?", categoryID).Count(&total).Error
+	return total, err
+}
+
+func (r *CategoryRepository) FindProductIdsByCategoryId(db *gorm.DB, categoryID uuid.UUID) ([]uuid.UUID, error) {
+	var productIDs []uuid.UUID
+
+	if err := db.Table("product_categories").Where("category_id = ?", categoryID).Pluck("product_id", &productIDs).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find product IDs by category ID")
+		return nil, err
+	}
+
+	return productIDs, nil
+}
+
+func (r *CategoryRepository) FindProductIdsByCategoryIds(db *gorm.DB, categoryIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var productIDs []uuid.UUID
+
+	if err := db.Table("product_categories").Distinct("product_id").Where("category_id IN ?", categoryIDs).Pluck("product_id", &productIDs).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find product IDs by category IDs")
+		return nil, err
+	}
+
+	return productIDs, nil
+}
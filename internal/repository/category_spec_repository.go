@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CategorySpecRepository struct {
+	Repository[entity.CategorySpec]
+	Log *logrus.Logger
+}
+
+func NewCategorySpecRepository(log *logrus.Logger) *CategorySpecRepository {
+	return &CategorySpecRepository{Log: log}
+}
+
+func (r *CategorySpecRepository) FindSpecsByCategoryIds(db *gorm.DB, categoryIDs []uuid.UUID) ([]entity.CategorySpec, error) {
+	var specs []entity.CategorySpec
+
+	if err := db.Where("category_id IN ?", categoryIDs).Order("position ASC").Find(&specs).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find category specs")
+		return nil, err
+	}
+
+	return specs, nil
+}
+
+func (r *CategorySpecRepository) ReplaceSpecs(db *gorm.DB, categoryID uuid.UUID, specs []entity.CategorySpec) error {
+	if err := db.Where("category_id = ?", categoryID).Delete(&entity.CategorySpec{}).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to delete category specs")
+		return err
+	}
+
+	if len(specs) == 0 {
+		return nil
+	}
+
+	if err := db.Create(&specs).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to create category specs")
+		return err
+	}
+
+	return nil
+}
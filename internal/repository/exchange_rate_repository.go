@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ExchangeRateRepository struct {
+	Repository[entity.ExchangeRate]
+	Log *logrus.Logger
+}
+
+func NewExchangeRateRepository(log *logrus.Logger) *ExchangeRateRepository {
+	return &ExchangeRateRepository{Log: log}
+}
+
+func (r *ExchangeRateRepository) GetAll(db *gorm.DB) ([]entity.ExchangeRate, error) {
+	var rates []entity.ExchangeRate
+
+	if err := db.Order("currency ASC").Find(&rates).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find exchange rates")
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+func (r *ExchangeRateRepository) FindByCurrencies(db *gorm.DB, currencies []string) (map[string]float64, error) {
+	var rates []entity.ExchangeRate
+
+	if err := db.Where("currency IN ?", currencies).Find(&rates).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find exchange rates by currencies")
+		return nil, err
+	}
+
+	result := make(map[string]float64, len(rates))
+	for _, rate := range rates {
+		result[rate.Currency] = rate.Rate
+	}
+
+	return result, nil
+}
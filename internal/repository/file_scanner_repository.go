@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"golectro-product/internal/model"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+const clamdChunkSize = 64 * 1024
+
+type FileScanner interface {
+	Scan(ctx context.Context, content io.Reader) (*model.ScanResult, error)
+}
+
+func NewFileScanner(viper *viper.Viper, log *logrus.Logger) FileScanner {
+	address := viper.GetString("CLAMAV_ADDRESS")
+	if address == "" {
+		log.Warn("CLAMAV_ADDRESS is not set, uploaded files will not be scanned")
+		return &NoopFileScanner{}
+	}
+
+	timeout := time.Duration(viper.GetInt("CLAMAV_TIMEOUT_SECONDS")) * time.Second
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	return NewClamAVScanner(address, timeout)
+}
+
+type NoopFileScanner struct{}
+
+func (s *NoopFileScanner) Scan(ctx context.Context, content io.Reader) (*model.ScanResult, error) {
+	return &model.ScanResult{Clean: true}, nil
+}
+
+type ClamAVScanner struct {
+	Network string
+	Address string
+	Timeout time.Duration
+}
+
+// NewClamAVScanner accepts "tcp://host:port", "unix:///path/to/clamd.sock" or
+// a bare "host:port", which is treated as TCP.
+func NewClamAVScanner(address string, timeout time.Duration) *ClamAVScanner {
+	network, addr := "tcp", address
+	if scheme, rest, ok := strings.Cut(address, "://"); ok {
+		network, addr = scheme, rest
+	}
+
+	return &ClamAVScanner{
+		Network: network,
+		Address: addr,
+		Timeout: timeout,
+	}
+}
+
+func (s *ClamAVScanner) Scan(ctx context.Context, content io.Reader) (*model.ScanResult, error) {
+	dialer := net.Dialer{Timeout: s.Timeout}
+	conn, err := dialer.DialContext(ctx, s.Network, s.Address)
+	if err != nil {
+		return nil, fmt.Errorf("connect to clamd: %w", err)
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(s.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, fmt.Errorf("start clamd stream: %w", err)
+	}
+
+	chunk := make([]byte, clamdChunkSize)
+	size := make([]byte, 4)
+	for {
+		n, err := content.Read(chunk)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size, uint32(n))
+			if _, err := conn.Write(size); err != nil {
+				return nil, fmt.Errorf("write clamd chunk: %w", err)
+			}
+			if _, err := conn.Write(chunk[:n]); err != nil {
+				return nil, fmt.Errorf("write clamd chunk: %w", err)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := conn.Write([]byte{0, 0, 0, 0}); err != nil {
+		return nil, fmt.Errorf("end clamd stream: %w", err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadBytes(0)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("read clamd reply: %w", err)
+	}
+
+	return parseClamdReply(string(bytes.TrimRight(reply, "\x00\n")))
+}
+
+func parseClamdReply(reply string) (*model.ScanResult, error) {
+	_, status, ok := strings.Cut(reply, ": ")
+	if !ok {
+		if strings.HasSuffix(reply, " ERROR") {
+			return nil, fmt.Errorf("clamd scan failed: %s", reply)
+		}
+		return nil, fmt.Errorf("unexpected clamd reply %q", reply)
+	}
+
+	switch {
+	case status == "OK":
+		return &model.ScanResult{Clean: true}, nil
+	case strings.HasSuffix(status, " FOUND"):
+		return &model.ScanResult{Signature: strings.TrimSuffix(status, " FOUND")}, nil
+	default:
+		return nil, fmt.Errorf("clamd scan failed: %s", status)
+	}
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeClamd struct {
+	listener net.Listener
+	received chan []byte
+}
+
+// startFakeClamd speaks just enough of the clamd INSTREAM protocol to test
+// the scanner: it reads the command and every chunk, then writes reply.
+// An empty reply closes the connection without answering.
+func startFakeClamd(t *testing.T, network, address, reply string) *fakeClamd {
+	t.Helper()
+
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	clamd := &fakeClamd{listener: listener, received: make(chan []byte, 1)}
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		command, err := reader.ReadString(0)
+		if err != nil || command != "zINSTREAM\x00" {
+			return
+		}
+		if reply == "" {
+			return
+		}
+
+		var content bytes.Buffer
+		size := make([]byte, 4)
+		for {
+			if _, err := io.ReadFull(reader, size); err != nil {
+				return
+			}
+			n := binary.BigEndian.Uint32(size)
+			if n == 0 {
+				break
+			}
+			if _, err := io.CopyN(&content, reader, int64(n)); err != nil {
+				return
+			}
+		}
+		clamd.received <- content.Bytes()
+
+		conn.Write([]byte(reply + "\x00"))
+	}()
+
+	return clamd
+}
+
+func (c *fakeClamd) address() string {
+	return c.listener.Addr().Network() + "://" + c.listener.Addr().String()
+}
+
+func TestClamAVScanner(t *testing.T) {
+	content := bytes.Repeat([]byte("golectro"), clamdChunkSize/4)
+
+	tests := []struct {
+		name          string
+		reply         string
+		wantClean     bool
+		wantSignature string
+		wantErr       string
+	}{
+		{name: "clean", reply: "stream: OK", wantClean: true},
+		{name: "infected", reply: "stream: Eicar-Test-Signature FOUND", wantSignature: "Eicar-Test-Signature"},
+		{name: "size limit", reply: "INSTREAM size limit exceeded. ERROR", wantErr: "size limit exceeded"},
+		{name: "dropped connection", wantErr: "clamd"},
+	}
+
+	listeners := []struct {
+		network string
+		address func(t *testing.T) string
+	}{
+		{"tcp", func(t *testing.T) string { return "127.0.0.1:0" }},
+		{"unix", func(t *testing.T) string { return filepath.Join(t.TempDir(), "clamd.sock") }},
+	}
+
+	for _, listener := range listeners {
+		for _, tt := range tests {
+			t.Run(listener.network+"/"+tt.name, func(t *testing.T) {
+				clamd := startFakeClamd(t, listener.network, listener.address(t), tt.reply)
+				scanner := NewClamAVScanner(clamd.address(), 5*time.Second)
+
+				result, err := scanner.Scan(context.Background(), bytes.NewReader(content))
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Fatalf("Scan() error = %v, want it to contain %q", err, tt.wantErr)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("Scan() error = %v", err)
+				}
+				if result.Clean != tt.wantClean || result.Signature != tt.wantSignature {
+					t.Fatalf("Scan() = %+v, want clean %v signature %q", result, tt.wantClean, tt.wantSignature)
+				}
+
+				select {
+				case received := <-clamd.received:
+					if !bytes.Equal(received, content) {
+						t.Fatalf("clamd received %d bytes, want %d", len(received), len(content))
+					}
+				case <-time.After(time.Second):
+					t.Fatal("clamd did not receive the stream")
+				}
+			})
+		}
+	}
+}
+
+func TestClamAVScannerUnreachable(t *testing.T) {
+	scanner := NewClamAVScanner("unix://"+filepath.Join(t.TempDir(), "missing.sock"), time.Second)
+
+	if _, err := scanner.Scan(context.Background(), strings.NewReader("content")); err == nil {
+		t.Fatal("Scan() error = nil, want a connection error")
+	}
+}
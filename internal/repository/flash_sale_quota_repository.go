@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+var claimFlashSaleScript = redis.NewScript(`
+local remaining = redis.call("GET", KEYS[1])
+if not remaining then
+	redis.call("SET", KEYS[1], ARGV[4], "PX", ARGV[5])
+	remaining = ARGV[4]
+end
+
+local quantity = tonumber(ARGV[1])
+if tonumber(remaining) < quantity then
+	return -1
+end
+
+local limit = tonumber(ARGV[2])
+if limit > 0 then
+	local bought = tonumber(redis.call("HGET", KEYS[2], ARGV[3]) or "0")
+	if bought + quantity > limit then
+		return -2
+	end
+end
+
+redis.call("HINCRBY", KEYS[2], ARGV[3], quantity)
+redis.call("PEXPIRE", KEYS[2], ARGV[5])
+return redis.call("DECRBY", KEYS[1], quantity)
+`)
+
+var releaseFlashSaleScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	redis.call("INCRBY", KEYS[1], ARGV[1])
+end
+if redis.call("HINCRBY", KEYS[2], ARGV[2], -tonumber(ARGV[1])) <= 0 then
+	redis.call("HDEL", KEYS[2], ARGV[2])
+end
+return 1
+`)
+
+var adjustFlashSaleScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return nil
+end
+local remaining = redis.call("INCRBY", KEYS[1], ARGV[1])
+if remaining < 0 then
+	redis.call("SET", KEYS[1], 0)
+	remaining = 0
+end
+redis.call("PEXPIRE", KEYS[1], ARGV[2])
+return remaining
+`)
+
+type FlashSaleQuotaRepository struct {
+	Client *redis.Client
+}
+
+func NewFlashSaleQuotaRepository(client *redis.Client) *FlashSaleQuotaRepository {
+	return &FlashSaleQuotaRepository{Client: client}
+}
+
+func (r *FlashSaleQuotaRepository) Init(ctx context.Context, saleID uuid.UUID, remaining int, ttl time.Duration) error {
+	return r.Client.SetNX(ctx, flashSaleRemainingKey(saleID), remaining, ttl).Err()
+}
+
+func (r *FlashSaleQuotaRepository) Claim(ctx context.Context, saleID, userID uuid.UUID, quantity, perUserLimit, initial int, ttl time.Duration) (int, error) {
+	keys := []string{flashSaleRemainingKey(saleID), flashSaleBuyersKey(saleID)}
+	return claimFlashSaleScript.Run(ctx, r.Client, keys, quantity, perUserLimit, userID.String(), initial, ttl.Milliseconds()).Int()
+}
+
+func (r *FlashSaleQuotaRepository) Release(ctx context.Context, saleID, userID uuid.UUID, quantity int) error {
+	keys := []string{flashSaleRemainingKey(saleID), flashSaleBuyersKey(saleID)}
+	return releaseFlashSaleScript.Run(ctx, r.Client, keys, quantity, userID.String()).Err()
+}
+
+func (r *FlashSaleQuotaRepository) Adjust(ctx context.Context, saleID uuid.UUID, delta int, ttl time.Duration) error {
+	err := adjustFlashSaleScript.Run(ctx, r.Client, []string{flashSaleRemainingKey(saleID)}, delta, ttl.Milliseconds()).Err()
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
+
+func (r *FlashSaleQuotaRepository) Remaining(ctx context.Context, saleID uuid.UUID) (int, bool, error) {
+	remaining, err := r.Client.Get(ctx, flashSaleRemainingKey(saleID)).Int()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return remaining, true, nil
+}
+
+func (r *FlashSaleQuotaRepository) Delete(ctx context.Context, saleID uuid.UUID) error {
+	return r.Client.Del(ctx, flashSaleRemainingKey(saleID), flashSaleBuyersKey(saleID)).Err()
+}
+
+func flashSaleRemainingKey(saleID uuid.UUID) string {
+	return fmt.Sprintf("flash_sale:{%s}:remaining", saleID)
+}
+
+func flashSaleBuyersKey(saleID uuid.UUID) string {
+	return fmt.Sprintf("flash_sale:{%s}:buyers", saleID)
+}
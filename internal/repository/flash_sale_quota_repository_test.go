@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"golectro-product/internal/model"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestFlashSaleQuotaRepository(t *testing.T) (*FlashSaleQuotaRepository, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewFlashSaleQuotaRepository(client), server
+}
+
+func TestFlashSaleQuotaConcurrentClaimsAtBoundary(t *testing.T) {
+	repo, _ := newTestFlashSaleQuotaRepository(t)
+	ctx := context.Background()
+	saleID := uuid.New()
+
+	const quota, buyers = 10, 50
+	results := make([]int, buyers)
+	errs := make([]error, buyers)
+
+	var wg sync.WaitGroup
+	for i := range buyers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = repo.Claim(ctx, saleID, uuid.New(), 1, 1, quota, time.Hour)
+		}()
+	}
+	wg.Wait()
+
+	claimed, soldOut := 0, 0
+	for i, result := range results {
+		if errs[i] != nil {
+			t.Fatalf("Claim() error = %v", errs[i])
+		}
+		switch {
+		case result == model.FlashSaleClaimSoldOut:
+			soldOut++
+		case result >= 0:
+			claimed++
+		default:
+			t.Fatalf("Claim() = %d, want a remaining quota or sold out", result)
+		}
+	}
+	if claimed != quota || soldOut != buyers-quota {
+		t.Fatalf("claimed %d and sold out %d, want %d and %d", claimed, soldOut, quota, buyers-quota)
+	}
+
+	remaining, ok, err := repo.Remaining(ctx, saleID)
+	if err != nil || !ok || remaining != 0 {
+		t.Fatalf("Remaining() = %d, %v, %v, want 0, true, nil", remaining, ok, err)
+	}
+}
+
+func TestFlashSaleQuotaClaimMoreThanRemaining(t *testing.T) {
+	repo, _ := newTestFlashSaleQuotaRepository(t)
+	ctx := context.Background()
+	saleID := uuid.New()
+
+	if remaining, err := repo.Claim(ctx, saleID, uuid.New(), 4, 0, 5, time.Hour); err != nil || remaining != 1 {
+		t.Fatalf("Claim() = %d, %v, want 1, nil", remaining, err)
+	}
+	if result, err := repo.Claim(ctx, saleID, uuid.New(), 2, 0, 5, time.Hour); err != nil || result != model.FlashSaleClaimSoldOut {
+		t.Fatalf("Claim() = %d, %v, want sold out", result, err)
+	}
+
+	remaining, _, err := repo.Remaining(ctx, saleID)
+	if err != nil || remaining != 1 {
+		t.Fatalf("Remaining() = %d, %v, want 1, nil", remaining, err)
+	}
+}
+
+func TestFlashSaleQuotaReleaseAfterFailedDecrement(t *testing.T) {
+	repo, server := newTestFlashSaleQuotaRepository(t)
+	ctx := context.Background()
+	saleID, userID := uuid.New(), uuid.New()
+
+	if remaining, err := repo.Claim(ctx, saleID, userID, 2, 2, 5, time.Hour); err != nil || remaining != 3 {
+		t.Fatalf("Claim() = %d, %v, want 3, nil", remaining, err)
+	}
+
+	// The stock decrement failed, so the order gives the claim back.
+	if err := repo.Release(ctx, saleID, userID, 2); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+
+	remaining, _, err := repo.Remaining(ctx, saleID)
+	if err != nil || remaining != 5 {
+		t.Fatalf("Remaining() = %d, %v, want 5, nil", remaining, err)
+	}
+	if server.HGet(flashSaleBuyersKey(saleID), userID.String()) != "" {
+		t.Fatalf("buyer %s is still recorded after release", userID)
+	}
+
+	if remaining, err := repo.Claim(ctx, saleID, userID, 2, 2, 5, time.Hour); err != nil || remaining != 3 {
+		t.Fatalf("Claim() after release = %d, %v, want 3, nil", remaining, err)
+	}
+}
+
+func TestFlashSaleQuotaReleaseDoesNotRecreateExpiredQuota(t *testing.T) {
+	repo, server := newTestFlashSaleQuotaRepository(t)
+	ctx := context.Background()
+	saleID, userID := uuid.New(), uuid.New()
+
+	if _, err := repo.Claim(ctx, saleID, userID, 1, 0, 5, time.Minute); err != nil {
+		t.Fatalf("Claim() error = %v", err)
+	}
+	server.FastForward(2 * time.Minute)
+
+	if err := repo.Release(ctx, saleID, userID, 1); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if _, ok, err := repo.Remaining(ctx, saleID); err != nil || ok {
+		t.Fatalf("Remaining() ok = %v, %v, want the expired quota to stay absent", ok, err)
+	}
+}
+
+func TestFlashSaleQuotaPerUserLimit(t *testing.T) {
+	repo, _ := newTestFlashSaleQuotaRepository(t)
+	ctx := context.Background()
+	saleID, userID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name     string
+		userID   uuid.UUID
+		quantity int
+		want     int
+	}{
+		{"first purchase", userID, 1, 9},
+		{"over the limit", userID, 2, model.FlashSaleClaimLimitReached},
+		{"up to the limit", userID, 1, 8},
+		{"limit reached", userID, 1, model.FlashSaleClaimLimitReached},
+		{"another buyer", uuid.New(), 2, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.Claim(ctx, saleID, tt.userID, tt.quantity, 2, 10, time.Hour)
+			if err != nil || got != tt.want {
+				t.Fatalf("Claim() = %d, %v, want %d, nil", got, err, tt.want)
+			}
+		})
+	}
+}
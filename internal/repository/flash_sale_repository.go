@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type FlashSaleRepository struct {
+	Repository[entity.FlashSale]
+	Log *logrus.Logger
+}
+
+func NewFlashSaleRepository(log *logrus.Logger) *FlashSaleRepository {
+	return &FlashSaleRepository{Log: log}
+}
+
+func (r *FlashSaleRepository) GetAll(db *gorm.DB) ([]entity.FlashSale, error) {
+	var sales []entity.FlashSale
+
+	if err := db.Order("starts_at DESC").Find(&sales).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find flash sales")
+		return nil, err
+	}
+
+	return sales, nil
+}
+
+func (r *FlashSaleRepository) FindFlashSaleById(db *gorm.DB, saleID uuid.UUID) (*entity.FlashSale, error) {
+	var sale entity.FlashSale
+
+	if err := db.First(&sale, "id = ?", saleID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &sale, nil
+}
+
+func (r *FlashSaleRepository) FindLiveByProductId(db *gorm.DB, productID uuid.UUID, now time.Time) (*entity.FlashSale, error) {
+	var sale entity.FlashSale
+
+	if err := db.Where("product_id = ? AND active = ? AND starts_at <= ? AND ends_at > ?", productID, true, now, now).
+		Order("starts_at DESC").
+		First(&sale).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &sale, nil
+}
+
+func (r *FlashSaleRepository) CountOverlapping(db *gorm.DB, productID uuid.UUID, startsAt, endsAt time.Time, excludeID uuid.UUID) (int64, error) {
+	var total int64
+	err := db.Model(&entity.FlashSale{}).
+		Where("product_id = ? AND id <> ? AND active = ? AND starts_at < ? AND ends_at > ?", productID, excludeID, true, endsAt, startsAt).
+		Count(&total).Error
+	return total, err
+}
+
+func (r *FlashSaleRepository) IncrementSold(db *gorm.DB, saleID uuid.UUID, quantity int) error {
+	return db.Model(&entity.FlashSale{}).Where("id = ?", saleID).UpdateColumn("sold", gorm.Expr("sold + ?", quantity)).Error
+}
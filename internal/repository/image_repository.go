@@ -1,11 +1,15 @@
 package repository
 
 import (
+	"fmt"
 	"golectro-product/internal/entity"
+	"golectro-product/internal/utils"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ImageRepository struct {
@@ -27,3 +31,131 @@ func (r *ImageRepository) FindImageById(db *gorm.DB, imageID uuid.UUID) (*entity
 
 	return &image, nil
 }
+
+func (r *ImageRepository) FindByProductId(db *gorm.DB, productID uuid.UUID) ([]entity.ProductImage, error) {
+	var images []entity.ProductImage
+
+	if err := orderImages(db).Where("product_id = ?", productID).Find(&images).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find images by product ID")
+		return nil, err
+	}
+
+	return images, nil
+}
+
+func (r *ImageRepository) ClearPrimary(db *gorm.DB, productID uuid.UUID) error {
+	return db.Model(&entity.ProductImage{}).Where("product_id = ? AND is_primary = ?", productID, true).Update("is_primary", false).Error
+}
+
+func (r *ImageRepository) FindAllObjectKeys(db *gorm.DB) ([]string, error) {
+	var keys []string
+
+	if err := db.Model(&entity.ProductImage{}).Pluck("image_object", &keys).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find image object keys")
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// FindSimilar narrows the candidates with the indexed hash bands before
+// computing the exact distance, so maxDistance must stay below
+// utils.PerceptualHashBandCount for the lookup to be complete.
+func (r *ImageRepository) FindSimilar(db *gorm.DB, hash uint64, maxDistance int) ([]entity.ProductImage, error) {
+	var images []entity.ProductImage
+
+	bands := utils.PerceptualHashBands(hash)
+	conditions := make([]string, len(bands))
+	args := make([]any, len(bands))
+	for i, band := range bands {
+		conditions[i] = fmt.Sprintf("hash_band%d = ?", i)
+		args[i] = band
+	}
+
+	if err := db.Where("("+strings.Join(conditions, " OR ")+")", args...).
+		Where("BIT_COUNT(perceptual_hash ^ ?) <= ?", hash, maxDistance).
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: "BIT_COUNT(perceptual_hash ^ ?) ASC", Vars: []any{hash}}}).
+		Find(&images).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find similar images")
+		return nil, err
+	}
+
+	return images, nil
+}
+
+func (r *ImageRepository) FindUngrouped(db *gorm.DB, afterID uuid.UUID, limit int) ([]entity.ProductImage, error) {
+	var images []entity.ProductImage
+
+	if err := db.Where("perceptual_hash IS NOT NULL AND duplicate_group_id IS NULL AND id > ?", afterID).
+		Order("id ASC").Limit(limit).Find(&images).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find ungrouped images")
+		return nil, err
+	}
+
+	return images, nil
+}
+
+func (r *ImageRepository) MergeDuplicateGroups(db *gorm.DB, groupID uuid.UUID, imageIDs, groupIDs []uuid.UUID) error {
+	query := db.Model(&entity.ProductImage{})
+	switch {
+	case len(imageIDs) > 0 && len(groupIDs) > 0:
+		query = query.Where("id IN ? OR duplicate_group_id IN ?", imageIDs, groupIDs)
+	case len(imageIDs) > 0:
+		query = query.Where("id IN ?", imageIDs)
+	default:
+		query = query.Where("duplicate_group_id IN ?", groupIDs)
+	}
+
+	if err := query.Update("duplicate_group_id", groupID).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to merge duplicate image groups")
+		return err
+	}
+
+	return nil
+}
+
+func (r *ImageRepository) CountDuplicateGroups(db *gorm.DB) (int64, error) {
+	var total int64
+
+	if err := db.Table("(?) AS duplicate_groups", duplicateGroups(db)).Count(&total).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to count duplicate image groups")
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *ImageRepository) FindDuplicateGroupIds(db *gorm.DB, limit, offset int) ([]uuid.UUID, error) {
+	var groupIDs []uuid.UUID
+
+	if err := duplicateGroups(db).Order("MIN(created_at) ASC, duplicate_group_id ASC").
+		Limit(limit).Offset(offset).Pluck("duplicate_group_id", &groupIDs).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find duplicate image groups")
+		return nil, err
+	}
+
+	return groupIDs, nil
+}
+
+func (r *ImageRepository) FindByDuplicateGroupIds(db *gorm.DB, groupIDs []uuid.UUID) ([]entity.ProductImage, error) {
+	var images []entity.ProductImage
+
+	if err := db.Where("duplicate_group_id IN ?", groupIDs).Order("product_id ASC, position ASC").Find(&images).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find images by duplicate group")
+		return nil, err
+	}
+
+	return images, nil
+}
+
+func duplicateGroups(db *gorm.DB) *gorm.DB {
+	return db.Model(&entity.ProductImage{}).
+		Select("duplicate_group_id").
+		Where("duplicate_group_id IS NOT NULL").
+		Group("duplicate_group_id").
+		Having("COUNT(*) > 1")
+}
+
+func orderImages(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC, created_at ASC")
+}
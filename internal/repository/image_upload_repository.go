@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ImageUploadRepository struct {
+	Repository[entity.ProductImageUpload]
+	Log *logrus.Logger
+}
+
+func NewImageUploadRepository(log *logrus.Logger) *ImageUploadRepository {
+	return &ImageUploadRepository{Log: log}
+}
+
+func (r *ImageUploadRepository) FindByIds(db *gorm.DB, productID uuid.UUID, uploadIDs []uuid.UUID) ([]entity.ProductImageUpload, error) {
+	var uploads []entity.ProductImageUpload
+
+	if err := db.Where("product_id = ? AND id IN ?", productID, uploadIDs).Order("created_at ASC, object_key ASC").Find(&uploads).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find image uploads by IDs")
+		return nil, err
+	}
+
+	return uploads, nil
+}
+
+func (r *ImageUploadRepository) FindPendingObjectKeys(db *gorm.DB, now time.Time) ([]string, error) {
+	var keys []string
+
+	if err := db.Model(&entity.ProductImageUpload{}).Where("status = ? AND expires_at > ?", model.ImageUploadStatusPending, now).Pluck("object_key", &keys).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find pending upload object keys")
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (r *ImageUploadRepository) ConfirmPending(db *gorm.DB, uploadIDs []uuid.UUID) (int64, error) {
+	result := db.Model(&entity.ProductImageUpload{}).
+		Where("id IN ? AND status = ?", uploadIDs, model.ImageUploadStatusPending).
+		Update("status", model.ImageUploadStatusConfirmed)
+	if result.Error != nil {
+		r.Log.WithError(result.Error).Error("Failed to confirm pending image uploads")
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
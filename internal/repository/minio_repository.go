@@ -20,7 +20,8 @@ func NewMinioRepository(client *minio.Client) *MinioRepository {
 
 func (r *MinioRepository) UploadFile(ctx context.Context, input model.UploadFileInput) error {
 	_, err := r.Client.PutObject(ctx, input.Bucket, input.ObjectKey, bytes.NewReader(input.Content), int64(len(input.Content)), minio.PutObjectOptions{
-		ContentType: input.ContentType,
+		ContentType:  input.ContentType,
+		UserMetadata: input.Metadata,
 	})
 	return err
 }
@@ -34,6 +35,14 @@ func (r *MinioRepository) GeneratePresignedURL(ctx context.Context, input model.
 	return presignedURL.String(), nil
 }
 
+func (r *MinioRepository) GeneratePresignedPutURL(ctx context.Context, input model.PresignedURLInput) (string, error) {
+	presignedURL, err := r.Client.PresignedPutObject(ctx, input.Bucket, input.ObjectKey, time.Duration(input.Expiry)*time.Second)
+	if err != nil {
+		return "", err
+	}
+	return presignedURL.String(), nil
+}
+
 func (r *MinioRepository) DeleteFile(ctx context.Context, bucket, objectKey string) error {
 	return r.Client.RemoveObject(ctx, bucket, objectKey, minio.RemoveObjectOptions{})
 }
@@ -45,3 +54,25 @@ func (r *MinioRepository) GetObject(ctx context.Context, bucket, objectKey strin
 	}
 	return object, nil
 }
+
+func (r *MinioRepository) StatObject(ctx context.Context, bucket, objectKey string) (*minio.ObjectInfo, error) {
+	info, err := r.Client.StatObject(ctx, bucket, objectKey, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (r *MinioRepository) ListObjects(ctx context.Context, bucket, prefix string) ([]minio.ObjectInfo, error) {
+	var objects []minio.ObjectInfo
+	for object := range r.Client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
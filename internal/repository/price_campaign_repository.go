@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type PriceCampaignRepository struct {
+	Repository[entity.PriceCampaign]
+	Log *logrus.Logger
+}
+
+func NewPriceCampaignRepository(log *logrus.Logger) *PriceCampaignRepository {
+	return &PriceCampaignRepository{Log: log}
+}
+
+func (r *PriceCampaignRepository) GetAll(db *gorm.DB) ([]entity.PriceCampaign, error) {
+	var campaigns []entity.PriceCampaign
+
+	if err := db.Order("starts_at DESC").Find(&campaigns).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find price campaigns")
+		return nil, err
+	}
+
+	return campaigns, nil
+}
+
+func (r *PriceCampaignRepository) FindPriceCampaignById(db *gorm.DB, campaignID uuid.UUID) (*entity.PriceCampaign, error) {
+	var campaign entity.PriceCampaign
+
+	if err := db.First(&campaign, "id = ?", campaignID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &campaign, nil
+}
+
+func (r *PriceCampaignRepository) FindLiveForTargets(db *gorm.DB, now time.Time, productIDs, categoryIDs, brandIDs []uuid.UUID) ([]entity.PriceCampaign, error) {
+	var campaigns []entity.PriceCampaign
+
+	targets := db.Where("scope = ? AND target_id IN ?", model.PriceCampaignScopeProduct, productIDs)
+	if len(categoryIDs) > 0 {
+		targets = targets.Or("scope = ? AND target_id IN ?", model.PriceCampaignScopeCategory, categoryIDs)
+	}
+	if len(brandIDs) > 0 {
+		targets = targets.Or("scope = ? AND target_id IN ?", model.PriceCampaignScopeBrand, brandIDs)
+	}
+
+	if err := db.Where("active = ? AND starts_at <= ? AND ends_at > ?", true, now, now).
+		Where(targets).
+		Find(&campaigns).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find live price campaigns")
+		return nil, err
+	}
+
+	return campaigns, nil
+}
+
+func (r *PriceCampaignRepository) FindDueForTransition(db *gorm.DB, now time.Time) ([]entity.PriceCampaign, error) {
+	var campaigns []entity.PriceCampaign
+
+	if err := db.
+		Where("applied = ? AND active = ? AND starts_at <= ? AND ends_at > ?", false, true, now, now).
+		Or("applied = ? AND (active = ? OR starts_at > ? OR ends_at <= ?)", true, false, now, now).
+		Find(&campaigns).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find price campaigns due for transition")
+		return nil, err
+	}
+
+	return campaigns, nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type PriceListRepository struct {
+	Repository[entity.PriceList]
+	Log *logrus.Logger
+}
+
+func NewPriceListRepository(log *logrus.Logger) *PriceListRepository {
+	return &PriceListRepository{Log: log}
+}
+
+func (r *PriceListRepository) GetAll(db *gorm.DB) ([]entity.PriceList, error) {
+	var priceLists []entity.PriceList
+
+	if err := db.Order("customer_group ASC").Find(&priceLists).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find price lists")
+		return nil, err
+	}
+
+	return priceLists, nil
+}
+
+func (r *PriceListRepository) FindPriceListById(db *gorm.DB, priceListID uuid.UUID) (*entity.PriceList, error) {
+	var priceList entity.PriceList
+
+	if err := db.First(&priceList, "id = ?", priceListID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &priceList, nil
+}
+
+func (r *PriceListRepository) FindByCustomerGroup(db *gorm.DB, customerGroup string) (*entity.PriceList, error) {
+	var priceList entity.PriceList
+
+	if err := db.First(&priceList, "customer_group = ?", customerGroup).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &priceList, nil
+}
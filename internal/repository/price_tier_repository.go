@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type PriceTierRepository struct {
+	Repository[entity.PriceTier]
+	Log *logrus.Logger
+}
+
+func NewPriceTierRepository(log *logrus.Logger) *PriceTierRepository {
+	return &PriceTierRepository{Log: log}
+}
+
+func (r *PriceTierRepository) FindByProductId(db *gorm.DB, productID uuid.UUID) ([]entity.PriceTier, error) {
+	var tiers []entity.PriceTier
+
+	if err := db.Where("product_id = ?", productID).
+		Order("price_list_id ASC, min_quantity ASC").
+		Find(&tiers).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find price tiers")
+		return nil, err
+	}
+
+	return tiers, nil
+}
+
+func (r *PriceTierRepository) FindDefaultByProductId(db *gorm.DB, productID uuid.UUID) ([]entity.PriceTier, error) {
+	var tiers []entity.PriceTier
+
+	if err := db.Where("product_id = ? AND price_list_id IS NULL", productID).
+		Order("min_quantity ASC").
+		Find(&tiers).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find default price tiers")
+		return nil, err
+	}
+
+	return tiers, nil
+}
+
+func (r *PriceTierRepository) FindApplicable(db *gorm.DB, productID uuid.UUID, quantity int, priceListID *uuid.UUID) ([]entity.PriceTier, error) {
+	var tiers []entity.PriceTier
+
+	scope := db.Where("price_list_id IS NULL")
+	if priceListID != nil {
+		scope = scope.Or("price_list_id = ?", *priceListID)
+	}
+
+	if err := db.Where("product_id = ? AND min_quantity <= ?", productID, quantity).
+		Where(scope).
+		Find(&tiers).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find applicable price tiers")
+		return nil, err
+	}
+
+	return tiers, nil
+}
+
+func (r *PriceTierRepository) Replace(db *gorm.DB, productID uuid.UUID, priceListID *uuid.UUID, tiers []entity.PriceTier) error {
+	query := db.Where("product_id = ?", productID)
+	if priceListID == nil {
+		query = query.Where("price_list_id IS NULL")
+	} else {
+		query = query.Where("price_list_id = ?", *priceListID)
+	}
+
+	if err := query.Delete(&entity.PriceTier{}).Error; err != nil {
+		return err
+	}
+
+	if len(tiers) == 0 {
+		return nil
+	}
+
+	return db.Omit("Product", "PriceList").Create(&tiers).Error
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductAnswerRepository struct {
+	Repository[entity.ProductAnswer]
+	Log *logrus.Logger
+}
+
+func NewProductAnswerRepository(log *logrus.Logger) *ProductAnswerRepository {
+	return &ProductAnswerRepository{Log: log}
+}
+
+func (r *ProductAnswerRepository) FindAnswerById(db *gorm.DB, answerID uuid.UUID) (*entity.ProductAnswer, error) {
+	var answer entity.ProductAnswer
+
+	if err := db.First(&answer, "id = ?", answerID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &answer, nil
+}
+
+func (r *ProductAnswerRepository) FindByStatus(db *gorm.DB, status string, limit, offset int) ([]entity.ProductAnswer, int64, error) {
+	var answers []entity.ProductAnswer
+	var total int64
+
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+
+	if err := db.Model(&entity.ProductAnswer{}).Count(&total).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to count product answers")
+		return nil, 0, err
+	}
+
+	if err := db.Order("created_at ASC").Limit(limit).Offset(offset).Find(&answers).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find product answers")
+		return nil, 0, err
+	}
+
+	return answers, total, nil
+}
+
+func (r *ProductAnswerRepository) FindIdsByQuestionId(db *gorm.DB, questionID uuid.UUID) ([]uuid.UUID, error) {
+	var answerIDs []uuid.UUID
+
+	if err := db.Model(&entity.ProductAnswer{}).Where("question_id = ?", questionID).Pluck("id", &answerIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return answerIDs, nil
+}
+
+func (r *ProductAnswerRepository) UpdateUpvoteCount(db *gorm.DB, answerID uuid.UUID, delta int) error {
+	return db.Model(&entity.ProductAnswer{}).
+		Where("id = ?", answerID).
+		UpdateColumn("upvote_count", gorm.Expr("GREATEST(upvote_count + ?, 0)", delta)).Error
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductPriceRepository struct {
+	Repository[entity.ProductPrice]
+	Log *logrus.Logger
+}
+
+func NewProductPriceRepository(log *logrus.Logger) *ProductPriceRepository {
+	return &ProductPriceRepository{Log: log}
+}
+
+func (r *ProductPriceRepository) FindByProductId(db *gorm.DB, productID uuid.UUID) ([]entity.ProductPrice, error) {
+	var prices []entity.ProductPrice
+
+	if err := db.Where("product_id = ?", productID).Order("effective_from DESC").Find(&prices).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find product prices")
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+func (r *ProductPriceRepository) FindLowestSince(db *gorm.DB, productIDs []uuid.UUID, since time.Time) (map[uuid.UUID]int64, error) {
+	lowest := make(map[uuid.UUID]int64, len(productIDs))
+	if len(productIDs) == 0 {
+		return lowest, nil
+	}
+
+	var rows []struct {
+		ProductID uuid.UUID
+		Price     int64
+	}
+
+	anchor := db.Model(&entity.ProductPrice{}).
+		Select("product_id, MAX(effective_from) AS effective_from").
+		Where("product_id IN ? AND effective_from < ?", productIDs, since).
+		Group("product_id")
+
+	if err := db.Model(&entity.ProductPrice{}).
+		Select("product_prices.product_id, MIN(product_prices.price) AS price").
+		Joins("LEFT JOIN (?) AS anchor ON anchor.product_id = product_prices.product_id", anchor).
+		Where("product_prices.product_id IN ?", productIDs).
+		Where("product_prices.effective_from >= ? OR product_prices.effective_from = anchor.effective_from", since).
+		Group("product_prices.product_id").
+		Scan(&rows).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find lowest product prices")
+		return nil, err
+	}
+
+	for _, row := range rows {
+		lowest[row.ProductID] = row.Price
+	}
+
+	return lowest, nil
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductQAVoteRepository struct {
+	Repository[entity.ProductQAVote]
+	Log *logrus.Logger
+}
+
+func NewProductQAVoteRepository(log *logrus.Logger) *ProductQAVoteRepository {
+	return &ProductQAVoteRepository{Log: log}
+}
+
+func (r *ProductQAVoteRepository) FindVote(db *gorm.DB, targetType string, targetID, userID uuid.UUID) (*entity.ProductQAVote, error) {
+	var vote entity.ProductQAVote
+
+	if err := db.First(&vote, "target_type = ? AND target_id = ? AND user_id = ?", targetType, targetID, userID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &vote, nil
+}
+
+func (r *ProductQAVoteRepository) DeleteByTargets(db *gorm.DB, targetType string, targetIDs []uuid.UUID) error {
+	if len(targetIDs) == 0 {
+		return nil
+	}
+
+	return db.Where("target_type = ? AND target_id IN ?", targetType, targetIDs).Delete(&entity.ProductQAVote{}).Error
+}
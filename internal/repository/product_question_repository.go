@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductQuestionRepository struct {
+	Repository[entity.ProductQuestion]
+	Log *logrus.Logger
+}
+
+func NewProductQuestionRepository(log *logrus.Logger) *ProductQuestionRepository {
+	return &ProductQuestionRepository{Log: log}
+}
+
+var questionSortOrders = map[string]string{
+	model.QuestionSortRecent: "created_at DESC",
+	model.QuestionSortVotes:  "upvote_count DESC, created_at DESC",
+}
+
+func preloadApprovedAnswers(db *gorm.DB) *gorm.DB {
+	return db.Where("status = ?", model.QAStatusApproved).Order("is_official DESC, upvote_count DESC, created_at ASC")
+}
+
+func (r *ProductQuestionRepository) FindQuestionById(db *gorm.DB, questionID uuid.UUID) (*entity.ProductQuestion, error) {
+	var question entity.ProductQuestion
+
+	if err := db.First(&question, "id = ?", questionID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &question, nil
+}
+
+func (r *ProductQuestionRepository) FindApprovedById(db *gorm.DB, productID, questionID uuid.UUID) (*entity.ProductQuestion, error) {
+	var question entity.ProductQuestion
+
+	if err := db.Preload("Answers", preloadApprovedAnswers).
+		First(&question, "id = ? AND product_id = ? AND status = ?", questionID, productID, model.QAStatusApproved).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &question, nil
+}
+
+func (r *ProductQuestionRepository) FindByProductId(db *gorm.DB, productID uuid.UUID, sort string, limit, offset int) ([]entity.ProductQuestion, int64, error) {
+	var questions []entity.ProductQuestion
+	var total int64
+
+	db = db.Where("product_id = ? AND status = ?", productID, model.QAStatusApproved)
+
+	if err := db.Model(&entity.ProductQuestion{}).Count(&total).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to count product questions")
+		return nil, 0, err
+	}
+
+	order, ok := questionSortOrders[sort]
+	if !ok {
+		order = questionSortOrders[model.QuestionSortRecent]
+	}
+
+	if err := db.Preload("Answers", preloadApprovedAnswers).Order(order).Limit(limit).Offset(offset).Find(&questions).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find product questions")
+		return nil, 0, err
+	}
+
+	return questions, total, nil
+}
+
+func (r *ProductQuestionRepository) FindByStatus(db *gorm.DB, status string, limit, offset int) ([]entity.ProductQuestion, int64, error) {
+	var questions []entity.ProductQuestion
+	var total int64
+
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+
+	if err := db.Model(&entity.ProductQuestion{}).Count(&total).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to count product questions")
+		return nil, 0, err
+	}
+
+	if err := db.Order("created_at ASC").Limit(limit).Offset(offset).Find(&questions).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find product questions")
+		return nil, 0, err
+	}
+
+	return questions, total, nil
+}
+
+func (r *ProductQuestionRepository) RefreshAnswerCount(db *gorm.DB, questionID uuid.UUID) error {
+	count := db.Model(&entity.ProductAnswer{}).
+		Select("COUNT(*)").
+		Where("question_id = ? AND status = ?", questionID, model.QAStatusApproved)
+
+	return db.Model(&entity.ProductQuestion{}).
+		Where("id = ?", questionID).
+		UpdateColumn("answer_count", count).Error
+}
+
+func (r *ProductQuestionRepository) UpdateUpvoteCount(db *gorm.DB, questionID uuid.UUID, delta int) error {
+	return db.Model(&entity.ProductQuestion{}).
+		Where("id = ?", questionID).
+		UpdateColumn("upvote_count", gorm.Expr("GREATEST(upvote_count + ?, 0)", delta)).Error
+}
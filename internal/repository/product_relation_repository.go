@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductRelationRepository struct {
+	Repository[entity.ProductRelation]
+	Log *logrus.Logger
+}
+
+func NewProductRelationRepository(log *logrus.Logger) *ProductRelationRepository {
+	return &ProductRelationRepository{Log: log}
+}
+
+func (r *ProductRelationRepository) FindByProductIds(db *gorm.DB, productIDs []uuid.UUID, types []string) ([]entity.ProductRelation, error) {
+	var relations []entity.ProductRelation
+
+	query := db.Where("product_id IN ?", productIDs)
+	if len(types) > 0 {
+		query = query.Where("type IN ?", types)
+	}
+
+	if err := query.Order("type ASC, position ASC, created_at ASC").Find(&relations).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find product relations")
+		return nil, err
+	}
+
+	return relations, nil
+}
+
+func (r *ProductRelationRepository) FindRelationById(db *gorm.DB, relationID uuid.UUID) (*entity.ProductRelation, error) {
+	var relation entity.ProductRelation
+
+	if err := db.First(&relation, "id = ?", relationID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &relation, nil
+}
+
+func (r *ProductRelationRepository) FindRelation(db *gorm.DB, productID, relatedProductID uuid.UUID, relationType string) (*entity.ProductRelation, error) {
+	var relation entity.ProductRelation
+
+	if err := db.First(&relation, "product_id = ? AND related_product_id = ? AND type = ?", productID, relatedProductID, relationType).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &relation, nil
+}
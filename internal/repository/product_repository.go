@@ -2,6 +2,8 @@ package repository
 
 import (
 	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -17,16 +19,20 @@ func NewProductRepository(log *logrus.Logger) *ProductRepository {
 	return &ProductRepository{Log: log}
 }
 
-func (r *ProductRepository) GetAll(db *gorm.DB, limit, offset int) ([]entity.Product, int64, error) {
+func (r *ProductRepository) GetAll(db *gorm.DB, limit, offset int, statuses []string) ([]entity.Product, int64, error) {
 	var products []entity.Product
 	var total int64
 
+	if len(statuses) > 0 {
+		db = db.Where("status IN ?", statuses)
+	}
+
 	if err := db.Model(&entity.Product{}).Count(&total).Error; err != nil {
 		r.Log.WithError(err).Error("Failed to count products")
 		return nil, 0, err
 	}
 
-	err := db.Preload("Images").
+	err := db.Preload("Images", orderImages).Preload("Categories").
 		Limit(limit).
 		Offset(offset).
 		Find(&products).Error
@@ -41,7 +47,28 @@ func (r *ProductRepository) GetAll(db *gorm.DB, limit, offset int) ([]entity.Pro
 func (r *ProductRepository) FindProductById(db *gorm.DB, productID uuid.UUID) (*entity.Product, error) {
 	var product entity.Product
 
-	if err := db.Preload("Images").First(&product, "id = ?", productID).Error; err != nil {
+	if err := db.Preload("Images", orderImages).Preload("Categories").First(&product, "id = ?", productID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+func (r *ProductRepository) FindProductByIdWithDeleted(db *gorm.DB, productID uuid.UUID) (*entity.Product, error) {
+	return r.FindProductById(db.Unscoped(), productID)
+}
+
+func (r *ProductRepository) FindProductsByIdsWithDeleted(db *gorm.DB, productIDs []uuid.UUID) ([]entity.Product, error) {
+	return r.FindProductsByIds(db.Unscoped(), productIDs)
+}
+
+func (r *ProductRepository) FindProductBySlug(db *gorm.DB, slug string) (*entity.Product, error) {
+	var product entity.Product
+
+	if err := db.Preload("Images", orderImages).Preload("Categories").First(&product, "slug = ?", slug).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
@@ -54,7 +81,7 @@ func (r *ProductRepository) FindProductById(db *gorm.DB, productID uuid.UUID) (*
 func (r *ProductRepository) FindProductsByIds(db *gorm.DB, productIDs []uuid.UUID) ([]entity.Product, error) {
 	var products []entity.Product
 
-	if err := db.Preload("Images").Where("id IN ?", productIDs).Find(&products).Error; err != nil {
+	if err := db.Preload("Images", orderImages).Preload("Categories").Where("id IN ?", productIDs).Find(&products).Error; err != nil {
 		r.Log.WithError(err).Error("Failed to find products by IDs")
 		return nil, err
 	}
@@ -62,6 +89,44 @@ func (r *ProductRepository) FindProductsByIds(db *gorm.DB, productIDs []uuid.UUI
 	return products, nil
 }
 
+func (r *ProductRepository) FindProductsByBrandId(db *gorm.DB, brandID uuid.UUID) ([]entity.Product, error) {
+	var products []entity.Product
+
+	if err := db.Preload("Images", orderImages).Preload("Categories").Where("brand_id = ?", brandID).Find(&products).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find products by brand ID")
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (r *ProductRepository) FindDueForPublish(db *gorm.DB, now time.Time) ([]entity.Product, error) {
+	var products []entity.Product
+
+	if err := db.Preload("Images", orderImages).Preload("Categories").
+		Where("status = ? AND publish_at IS NOT NULL AND publish_at <= ?", model.ProductStatusInReview, now).
+		Where("unpublish_at IS NULL OR unpublish_at > ?", now).
+		Find(&products).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find products due for publish")
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (r *ProductRepository) FindDueForUnpublish(db *gorm.DB, now time.Time) ([]entity.Product, error) {
+	var products []entity.Product
+
+	if err := db.Preload("Images", orderImages).Preload("Categories").
+		Where("status = ? AND unpublish_at IS NOT NULL AND unpublish_at <= ?", model.ProductStatusPublished, now).
+		Find(&products).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find products due for unpublish")
+		return nil, err
+	}
+
+	return products, nil
+}
+
 func (r *ProductRepository) CreateImage(db *gorm.DB, productImage *entity.ProductImage) error {
 	return db.Create(productImage).Error
 }
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductReviewRepository struct {
+	Repository[entity.ProductReview]
+	Log *logrus.Logger
+}
+
+func NewProductReviewRepository(log *logrus.Logger) *ProductReviewRepository {
+	return &ProductReviewRepository{Log: log}
+}
+
+var reviewSortOrders = map[string]string{
+	model.ReviewSortRecent:  "created_at DESC",
+	model.ReviewSortHelpful: "helpful_count DESC, created_at DESC",
+	model.ReviewSortHighest: "rating DESC, created_at DESC",
+	model.ReviewSortLowest:  "rating ASC, created_at DESC",
+}
+
+func (r *ProductReviewRepository) FindReviewById(db *gorm.DB, reviewID uuid.UUID) (*entity.ProductReview, error) {
+	var review entity.ProductReview
+
+	if err := db.Preload("Photos").First(&review, "id = ?", reviewID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+func (r *ProductReviewRepository) FindByProductAndUser(db *gorm.DB, productID, userID uuid.UUID) (*entity.ProductReview, error) {
+	var review entity.ProductReview
+
+	if err := db.First(&review, "product_id = ? AND user_id = ?", productID, userID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+func (r *ProductReviewRepository) FindByProductId(db *gorm.DB, productID uuid.UUID, status string, sort string, limit, offset int) ([]entity.ProductReview, int64, error) {
+	return r.find(db.Where("product_id = ? AND status = ?", productID, status), sort, limit, offset)
+}
+
+func (r *ProductReviewRepository) FindByStatus(db *gorm.DB, status string, limit, offset int) ([]entity.ProductReview, int64, error) {
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	return r.find(db, model.ReviewSortRecent, limit, offset)
+}
+
+func (r *ProductReviewRepository) find(db *gorm.DB, sort string, limit, offset int) ([]entity.ProductReview, int64, error) {
+	var reviews []entity.ProductReview
+	var total int64
+
+	if err := db.Model(&entity.ProductReview{}).Count(&total).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to count product reviews")
+		return nil, 0, err
+	}
+
+	order, ok := reviewSortOrders[sort]
+	if !ok {
+		order = reviewSortOrders[model.ReviewSortRecent]
+	}
+
+	if err := db.Preload("Photos").Order(order).Limit(limit).Offset(offset).Find(&reviews).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find product reviews")
+		return nil, 0, err
+	}
+
+	return reviews, total, nil
+}
+
+func (r *ProductReviewRepository) CountRatings(db *gorm.DB, productID uuid.UUID) (map[int]int64, error) {
+	var rows []struct {
+		Rating int
+		Total  int64
+	}
+
+	if err := db.Model(&entity.ProductReview{}).
+		Select("rating, COUNT(*) AS total").
+		Where("product_id = ? AND status = ?", productID, model.ReviewStatusApproved).
+		Group("rating").
+		Scan(&rows).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to count product review ratings")
+		return nil, err
+	}
+
+	distribution := map[int]int64{1: 0, 2: 0, 3: 0, 4: 0, 5: 0}
+	for _, row := range rows {
+		distribution[row.Rating] = row.Total
+	}
+
+	return distribution, nil
+}
+
+func (r *ProductReviewRepository) CreatePhotos(db *gorm.DB, photos []entity.ProductReviewPhoto) error {
+	return db.Create(&photos).Error
+}
+
+func (r *ProductReviewRepository) UpdateHelpfulCount(db *gorm.DB, reviewID uuid.UUID, delta int) error {
+	return db.Model(&entity.ProductReview{}).
+		Where("id = ?", reviewID).
+		UpdateColumn("helpful_count", gorm.Expr("GREATEST(helpful_count + ?, 0)", delta)).Error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductReviewVoteRepository struct {
+	Repository[entity.ProductReviewVote]
+	Log *logrus.Logger
+}
+
+func NewProductReviewVoteRepository(log *logrus.Logger) *ProductReviewVoteRepository {
+	return &ProductReviewVoteRepository{Log: log}
+}
+
+func (r *ProductReviewVoteRepository) FindVote(db *gorm.DB, reviewID, userID uuid.UUID) (*entity.ProductReviewVote, error) {
+	var vote entity.ProductReviewVote
+
+	if err := db.First(&vote, "review_id = ? AND user_id = ?", reviewID, userID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &vote, nil
+}
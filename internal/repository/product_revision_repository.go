@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductRevisionRepository struct {
+	Repository[entity.ProductRevision]
+	Log *logrus.Logger
+}
+
+func NewProductRevisionRepository(log *logrus.Logger) *ProductRevisionRepository {
+	return &ProductRevisionRepository{Log: log}
+}
+
+func (r *ProductRevisionRepository) FindByProductId(db *gorm.DB, productID uuid.UUID) ([]entity.ProductRevision, error) {
+	var revisions []entity.ProductRevision
+
+	if err := db.Where("product_id = ?", productID).Order("revision DESC").Find(&revisions).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find product revisions")
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (r *ProductRevisionRepository) FindRevision(db *gorm.DB, productID uuid.UUID, revision int) (*entity.ProductRevision, error) {
+	var productRevision entity.ProductRevision
+
+	if err := db.Where("product_id = ? AND revision = ?", productID, revision).First(&productRevision).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &productRevision, nil
+}
+
+func (r *ProductRevisionRepository) FindLatest(db *gorm.DB, productID uuid.UUID) (*entity.ProductRevision, error) {
+	var productRevision entity.ProductRevision
+
+	if err := db.Where("product_id = ?", productID).Order("revision DESC").First(&productRevision).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &productRevision, nil
+}
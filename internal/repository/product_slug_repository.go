@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductSlugRepository struct {
+	Repository[entity.ProductSlug]
+	Log *logrus.Logger
+}
+
+func NewProductSlugRepository(log *logrus.Logger) *ProductSlugRepository {
+	return &ProductSlugRepository{Log: log}
+}
+
+func (r *ProductSlugRepository) FindBySlug(db *gorm.DB, slug string) (*entity.ProductSlug, error) {
+	var productSlug entity.ProductSlug
+
+	if err := db.First(&productSlug, "slug = ?", slug).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &productSlug, nil
+}
+
+func (r *ProductSlugRepository) IsTaken(db *gorm.DB, slug string, productID uuid.UUID) (bool, error) {
+	var total int64
+
+	if err := db.Model(&entity.ProductSlug{}).Where("slug = ? AND product_id <> ?", slug, productID).Count(&total).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to count product slugs")
+		return false, err
+	}
+
+	return total > 0, nil
+}
+
+func (r *ProductSlugRepository) Record(db *gorm.DB, productID uuid.UUID, slug string) error {
+	existing, err := r.FindBySlug(db, slug)
+	if err != nil {
+		r.Log.WithError(err).Error("Failed to find product slug")
+		return err
+	}
+
+	if existing != nil {
+		return nil
+	}
+
+	return db.Create(&entity.ProductSlug{ID: uuid.New(), ProductID: productID, Slug: slug}).Error
+}
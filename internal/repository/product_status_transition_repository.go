@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"golectro-product/internal/entity"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductStatusTransitionRepository struct {
+	Repository[entity.ProductStatusTransition]
+	Log *logrus.Logger
+}
+
+func NewProductStatusTransitionRepository(log *logrus.Logger) *ProductStatusTransitionRepository {
+	return &ProductStatusTransitionRepository{Log: log}
+}
+
+func (r *ProductStatusTransitionRepository) FindByProductId(db *gorm.DB, productID uuid.UUID) ([]entity.ProductStatusTransition, error) {
+	var transitions []entity.ProductStatusTransition
+
+	if err := db.Where("product_id = ?", productID).Order("created_at DESC").Find(&transitions).Error; err != nil {
+		r.Log.WithError(err).Error("Failed to find product status transitions")
+		return nil, err
+	}
+
+	return transitions, nil
+}
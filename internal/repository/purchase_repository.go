@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+type PurchaseVerifier interface {
+	HasPurchased(ctx context.Context, userID uuid.UUID, productID uuid.UUID) (bool, error)
+}
+
+type LocalPurchaseVerifier struct {
+	AllowAll  bool
+	Purchases map[uuid.UUID]map[uuid.UUID]bool
+}
+
+func NewLocalPurchaseVerifier(viper *viper.Viper, log *logrus.Logger) *LocalPurchaseVerifier {
+	verifier := &LocalPurchaseVerifier{
+		AllowAll:  viper.GetBool("LOCAL_PURCHASE_ALLOW_ALL"),
+		Purchases: map[uuid.UUID]map[uuid.UUID]bool{},
+	}
+
+	if viper.GetString("WEB_MODE") == "release" {
+		if verifier.AllowAll {
+			log.Fatal("LOCAL_PURCHASE_ALLOW_ALL must not be enabled when WEB_MODE is release")
+		}
+		log.Warn("Using the local purchase verifier in release mode, only LOCAL_PURCHASES entries count as verified purchases")
+	}
+
+	for entry := range strings.SplitSeq(viper.GetString("LOCAL_PURCHASES"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		user, product, _ := strings.Cut(entry, ":")
+		userID, err := uuid.Parse(strings.TrimSpace(user))
+		if err != nil {
+			log.Warnf("Ignoring invalid local purchase entry %q", entry)
+			continue
+		}
+		productID, err := uuid.Parse(strings.TrimSpace(product))
+		if err != nil {
+			log.Warnf("Ignoring invalid local purchase entry %q", entry)
+			continue
+		}
+
+		if verifier.Purchases[userID] == nil {
+			verifier.Purchases[userID] = map[uuid.UUID]bool{}
+		}
+		verifier.Purchases[userID][productID] = true
+	}
+
+	return verifier
+}
+
+func (v *LocalPurchaseVerifier) HasPurchased(ctx context.Context, userID uuid.UUID, productID uuid.UUID) (bool, error) {
+	return v.AllowAll || v.Purchases[userID][productID], nil
+}
@@ -0,0 +1,308 @@
+package usecase
+
+import (
+	"context"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type BrandUseCase struct {
+	DB                   *gorm.DB
+	Log                  *logrus.Logger
+	Validate             *validator.Validate
+	Viper                *viper.Viper
+	BrandRepository      *repository.BrandRepository
+	ProductRepository    *repository.ProductRepository
+	MinioUseCase         *MinioUseCase
+	ElasticsearchUseCase *ElasticsearchUseCase
+}
+
+func NewBrandUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, viper *viper.Viper, brandRepository *repository.BrandRepository, productRepository *repository.ProductRepository, minioUseCase *MinioUseCase, elasticsearchUseCase *ElasticsearchUseCase) *BrandUseCase {
+	return &BrandUseCase{
+		DB:                   db,
+		Log:                  log,
+		Validate:             validate,
+		Viper:                viper,
+		BrandRepository:      brandRepository,
+		ProductRepository:    productRepository,
+		MinioUseCase:         minioUseCase,
+		ElasticsearchUseCase: elasticsearchUseCase,
+	}
+}
+
+func (uc *BrandUseCase) GetAllBrands(ctx context.Context) ([]*model.BrandResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	brands, err := uc.BrandRepository.GetAll(db)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to get brands")
+		return nil, utils.WrapMessageAsError(constants.FailedGetBrands, err)
+	}
+
+	counts, err := uc.BrandRepository.CountProductsByBrand(db)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to count products by brand")
+		return nil, utils.WrapMessageAsError(constants.FailedGetBrands, err)
+	}
+
+	responses := make([]*model.BrandResponse, 0, len(brands))
+	for i := range brands {
+		response := converter.ToBrandResponse(&brands[i])
+		response.ProductCount = counts[brands[i].ID]
+		response.LogoURL = uc.logoURL(ctx, brands[i].LogoObject)
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+func (uc *BrandUseCase) GetBrandByID(ctx context.Context, brandID uuid.UUID) (*model.BrandResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	brand, err := uc.BrandRepository.FindBrandById(db, brandID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find brand by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetBrandByID, err)
+	}
+
+	if brand == nil {
+		return nil, utils.WrapMessageAsError(constants.BrandNotFound)
+	}
+
+	total, err := uc.BrandRepository.CountProducts(db, brand.ID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to count brand products")
+		return nil, utils.WrapMessageAsError(constants.FailedGetBrandByID, err)
+	}
+
+	response := converter.ToBrandResponse(brand)
+	response.ProductCount = total
+	response.LogoURL = uc.logoURL(ctx, brand.LogoObject)
+
+	return response, nil
+}
+
+func (uc *BrandUseCase) CreateBrand(ctx context.Context, request *model.BrandRequest) (*model.BrandResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	brand := &entity.Brand{ID: uuid.New()}
+	if err := uc.applyBrandRequest(tx, brand, request); err != nil {
+		return nil, err
+	}
+
+	if err := uc.BrandRepository.Create(tx, brand); err != nil {
+		uc.Log.WithError(err).Error("Failed to create brand")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateBrand, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for brand creation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateBrand, err)
+	}
+
+	return converter.ToBrandResponse(brand), nil
+}
+
+func (uc *BrandUseCase) UpdateBrand(ctx context.Context, brandID uuid.UUID, request *model.BrandRequest) (*model.BrandResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	brand, err := uc.BrandRepository.FindBrandById(tx, brandID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find brand by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetBrandByID, err)
+	}
+
+	if brand == nil {
+		return nil, utils.WrapMessageAsError(constants.BrandNotFound)
+	}
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	previousName := brand.Name
+	if err := uc.applyBrandRequest(tx, brand, request); err != nil {
+		return nil, err
+	}
+
+	if err := uc.BrandRepository.Update(tx, brand); err != nil {
+		uc.Log.WithError(err).Error("Failed to update brand")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateBrand, err)
+	}
+
+	var products []entity.Product
+	if brand.Name != previousName {
+		products, err = uc.ProductRepository.FindProductsByBrandId(tx, brand.ID)
+		if err != nil {
+			return nil, utils.WrapMessageAsError(constants.FailedUpdateBrand, err)
+		}
+
+		for i := range products {
+			products[i].Brand = brand.Name
+			if err := tx.Model(&products[i]).UpdateColumn("brand", brand.Name).Error; err != nil {
+				uc.Log.WithError(err).Error("Failed to refresh product brand name")
+				return nil, utils.WrapMessageAsError(constants.FailedUpdateBrand, err)
+			}
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for brand update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateBrand, err)
+	}
+
+	for i := range products {
+		if err := uc.ElasticsearchUseCase.InsertDocument(products[i].ID, &products[i]); err != nil {
+			uc.Log.WithError(err).Warnf("Failed to reindex product %s after brand change", products[i].ID)
+		}
+	}
+
+	return converter.ToBrandResponse(brand), nil
+}
+
+func (uc *BrandUseCase) DeleteBrand(ctx context.Context, brandID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	brand, err := uc.BrandRepository.FindBrandById(tx, brandID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find brand by ID")
+		return utils.WrapMessageAsError(constants.FailedGetBrandByID, err)
+	}
+
+	if brand == nil {
+		return utils.WrapMessageAsError(constants.BrandNotFound)
+	}
+
+	total, err := uc.BrandRepository.CountProducts(tx, brand.ID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to count brand products")
+		return utils.WrapMessageAsError(constants.FailedDeleteBrand, err)
+	}
+
+	if total > 0 {
+		return utils.WrapMessageAsError(constants.BrandHasProducts)
+	}
+
+	if err := uc.BrandRepository.Delete(tx, brand); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete brand")
+		return utils.WrapMessageAsError(constants.FailedDeleteBrand, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for brand deletion")
+		return utils.WrapMessageAsError(constants.FailedDeleteBrand, err)
+	}
+
+	if brand.LogoObject != "" {
+		if err := uc.MinioUseCase.Delete(ctx, uc.Viper.GetString("MINIO_BUCKET_BRAND"), brand.LogoObject); err != nil {
+			uc.Log.WithError(err).Warn("Failed to delete brand logo from Minio")
+		}
+	}
+
+	return nil
+}
+
+func (uc *BrandUseCase) UpdateBrandLogo(ctx context.Context, brandID uuid.UUID, logoObject string) (*model.BrandResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	brand, err := uc.BrandRepository.FindBrandById(tx, brandID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find brand by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetBrandByID, err)
+	}
+
+	if brand == nil {
+		return nil, utils.WrapMessageAsError(constants.BrandNotFound)
+	}
+
+	previousLogo := brand.LogoObject
+	brand.LogoObject = logoObject
+
+	if err := uc.BrandRepository.Update(tx, brand); err != nil {
+		uc.Log.WithError(err).Error("Failed to update brand logo")
+		return nil, utils.WrapMessageAsError(constants.FailedUploadBrandLogo, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for brand logo update")
+		return nil, utils.WrapMessageAsError(constants.FailedUploadBrandLogo, err)
+	}
+
+	if previousLogo != "" && previousLogo != logoObject {
+		if err := uc.MinioUseCase.Delete(ctx, uc.Viper.GetString("MINIO_BUCKET_BRAND"), previousLogo); err != nil {
+			uc.Log.WithError(err).Warn("Failed to delete previous brand logo from Minio")
+		}
+	}
+
+	response := converter.ToBrandResponse(brand)
+	response.LogoURL = uc.logoURL(ctx, brand.LogoObject)
+
+	return response, nil
+}
+
+func (uc *BrandUseCase) applyBrandRequest(tx *gorm.DB, brand *entity.Brand, request *model.BrandRequest) error {
+	name := strings.Join(strings.Fields(request.Name), " ")
+
+	slug := utils.Slugify(request.Slug)
+	if slug == "" {
+		slug = utils.Slugify(name)
+	}
+
+	total, err := uc.BrandRepository.CountBySlug(tx, slug, brand.ID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to count brands by slug")
+		return utils.WrapMessageAsError(constants.FailedCreateBrand, err)
+	}
+
+	if total > 0 {
+		return utils.WrapMessageAsError(constants.BrandSlugAlreadyExists)
+	}
+
+	brand.Name = name
+	brand.Slug = slug
+	brand.Country = strings.ToUpper(request.Country)
+	brand.IsOfficialStore = request.IsOfficialStore
+
+	return nil
+}
+
+func (uc *BrandUseCase) logoURL(ctx context.Context, logoObject string) string {
+	if logoObject == "" {
+		return ""
+	}
+
+	url, err := uc.MinioUseCase.GetPresignedURL(ctx, model.PresignedURLInput{
+		Bucket:    uc.Viper.GetString("MINIO_BUCKET_BRAND"),
+		ObjectKey: logoObject,
+		Expiry:    int64((time.Hour * 24).Seconds()),
+	})
+	if err != nil {
+		uc.Log.WithError(err).Warn("Failed to get presigned URL for brand logo")
+		return ""
+	}
+
+	return url
+}
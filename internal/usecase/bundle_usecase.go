@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+	"math"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BundleUseCase struct {
+	DB                        *gorm.DB
+	Log                       *logrus.Logger
+	Validate                  *validator.Validate
+	ProductRepository         *repository.ProductRepository
+	BundleComponentRepository *repository.BundleComponentRepository
+	ElasticsearchUseCase      *ElasticsearchUseCase
+}
+
+func NewBundleUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, productRepository *repository.ProductRepository, bundleComponentRepository *repository.BundleComponentRepository, elasticsearchUseCase *ElasticsearchUseCase) *BundleUseCase {
+	return &BundleUseCase{
+		DB:                        db,
+		Log:                       log,
+		Validate:                  validate,
+		ProductRepository:         productRepository,
+		BundleComponentRepository: bundleComponentRepository,
+		ElasticsearchUseCase:      elasticsearchUseCase,
+	}
+}
+
+func (uc *BundleUseCase) UpdateBundleComponents(ctx context.Context, bundleID uuid.UUID, request *model.BundleComponentsRequest) ([]*model.BundleComponentResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	bundle, err := uc.ProductRepository.FindProductById(tx, bundleID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+
+	if bundle == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	if bundle.Type != model.ProductTypeBundle {
+		return nil, utils.WrapMessageAsError(constants.ProductNotBundle)
+	}
+
+	components, err := buildBundleComponents(tx, uc.ProductRepository, bundle, request.Components)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.BundleComponentRepository.Replace(tx, bundle.ID, components); err != nil {
+		uc.Log.WithError(err).Error("Failed to replace bundle components")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateBundleComponents, err)
+	}
+
+	bundles, err := syncBundleStock(tx, uc.ProductRepository, uc.BundleComponentRepository, []uuid.UUID{bundle.ID})
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to sync bundle stock")
+		return nil, utils.WrapMessageAsError(constants.FailedSyncBundleStock, err)
+	}
+
+	responses, err := bundleComponentResponses(tx, uc.ProductRepository, components)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateBundleComponents, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for bundle component update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateBundleComponents, err)
+	}
+
+	for _, product := range bundles {
+		if err := uc.ElasticsearchUseCase.InsertDocument(product.ID, product); err != nil {
+			uc.Log.WithError(err).Warnf("Failed to reindex bundle %s after component update", product.ID)
+		}
+	}
+
+	return responses, nil
+}
+
+func buildBundleComponents(tx *gorm.DB, productRepository *repository.ProductRepository, bundle *entity.Product, requests []model.BundleComponentRequest) ([]entity.BundleComponent, error) {
+	componentIDs := make([]uuid.UUID, 0, len(requests))
+	for _, request := range requests {
+		if request.ComponentID == bundle.ID {
+			return nil, utils.WrapMessageAsError(constants.BundleComponentInvalid)
+		}
+		componentIDs = append(componentIDs, request.ComponentID)
+	}
+
+	products, err := productRepository.FindProductsByIds(tx, componentIDs)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateBundleComponents, err)
+	}
+
+	if len(products) != len(componentIDs) {
+		return nil, utils.WrapMessageAsError(constants.BundleComponentNotFound)
+	}
+
+	for _, product := range products {
+		if product.Type == model.ProductTypeBundle {
+			return nil, utils.WrapMessageAsError(constants.BundleComponentInvalid)
+		}
+	}
+
+	components := make([]entity.BundleComponent, 0, len(requests))
+	for _, request := range requests {
+		components = append(components, entity.BundleComponent{
+			ID:          uuid.New(),
+			BundleID:    bundle.ID,
+			ComponentID: request.ComponentID,
+			Quantity:    request.Quantity,
+		})
+	}
+
+	return components, nil
+}
+
+func bundleComponentResponses(db *gorm.DB, productRepository *repository.ProductRepository, components []entity.BundleComponent) ([]*model.BundleComponentResponse, error) {
+	componentIDs := make([]uuid.UUID, 0, len(components))
+	for _, component := range components {
+		componentIDs = append(componentIDs, component.ComponentID)
+	}
+
+	products, err := productRepository.FindProductsByIds(db, componentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*entity.Product, len(products))
+	for i := range products {
+		byID[products[i].ID] = &products[i]
+	}
+
+	responses := make([]*model.BundleComponentResponse, 0, len(components))
+	for _, component := range components {
+		response := &model.BundleComponentResponse{
+			ComponentID: component.ComponentID,
+			Quantity:    component.Quantity,
+		}
+		if product, ok := byID[component.ComponentID]; ok {
+			response.Name = product.Name
+			response.Slug = product.Slug
+			response.Available = product.Quantity
+			response.EffectivePrice = product.EffectivePrice
+		}
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+func bundleComponentIDs(components []entity.BundleComponent) []uuid.UUID {
+	componentIDs := make([]uuid.UUID, 0, len(components))
+	for _, component := range components {
+		componentIDs = append(componentIDs, component.ComponentID)
+	}
+	return componentIDs
+}
+
+func deriveBundleQuantity(components []entity.BundleComponent, stock map[uuid.UUID]int) int {
+	if len(components) == 0 {
+		return 0
+	}
+
+	available := math.MaxInt
+	for _, component := range components {
+		available = min(available, stock[component.ComponentID]/component.Quantity)
+	}
+
+	return available
+}
+
+func findComponentStock(tx *gorm.DB, componentIDs []uuid.UUID, lock bool) (map[uuid.UUID]int, error) {
+	var products []entity.Product
+
+	query := tx.Select("id", "quantity").Where("id IN ?", componentIDs)
+	if lock {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	if err := query.Find(&products).Error; err != nil {
+		return nil, err
+	}
+
+	stock := make(map[uuid.UUID]int, len(products))
+	for _, product := range products {
+		stock[product.ID] = product.Quantity
+	}
+
+	return stock, nil
+}
+
+func refreshBundleStock(tx *gorm.DB, productRepository *repository.ProductRepository, bundleComponentRepository *repository.BundleComponentRepository, componentIDs []uuid.UUID) ([]*entity.Product, error) {
+	bundleIDs, err := bundleComponentRepository.FindBundleIdsByComponentIds(tx, componentIDs)
+	if err != nil || len(bundleIDs) == 0 {
+		return nil, err
+	}
+
+	return syncBundleStock(tx, productRepository, bundleComponentRepository, bundleIDs)
+}
+
+func syncBundleStock(tx *gorm.DB, productRepository *repository.ProductRepository, bundleComponentRepository *repository.BundleComponentRepository, bundleIDs []uuid.UUID) ([]*entity.Product, error) {
+	components, err := bundleComponentRepository.FindByBundleIds(tx, bundleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byBundle := make(map[uuid.UUID][]entity.BundleComponent, len(bundleIDs))
+	componentIDs := make([]uuid.UUID, 0, len(components))
+	for _, component := range components {
+		byBundle[component.BundleID] = append(byBundle[component.BundleID], component)
+		componentIDs = append(componentIDs, component.ComponentID)
+	}
+
+	stock := map[uuid.UUID]int{}
+	if len(componentIDs) > 0 {
+		if stock, err = findComponentStock(tx, componentIDs, false); err != nil {
+			return nil, err
+		}
+	}
+
+	bundles, err := productRepository.FindProductsByIds(tx, bundleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []*entity.Product
+	for i := range bundles {
+		quantity := deriveBundleQuantity(byBundle[bundles[i].ID], stock)
+		if quantity == bundles[i].Quantity {
+			continue
+		}
+
+		bundles[i].Quantity = quantity
+		bundles[i].UpdatedAt = time.Now()
+		if err := tx.Model(&bundles[i]).UpdateColumns(map[string]any{
+			"quantity":   bundles[i].Quantity,
+			"updated_at": bundles[i].UpdatedAt,
+		}).Error; err != nil {
+			return nil, err
+		}
+		changed = append(changed, &bundles[i])
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,504 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+	"slices"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CategoryUseCase struct {
+	DB                     *gorm.DB
+	Log                    *logrus.Logger
+	Validate               *validator.Validate
+	CategoryRepository     *repository.CategoryRepository
+	CategorySpecRepository *repository.CategorySpecRepository
+	ProductRepository      *repository.ProductRepository
+	ElasticsearchUseCase   *ElasticsearchUseCase
+}
+
+func NewCategoryUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, categoryRepository *repository.CategoryRepository, categorySpecRepository *repository.CategorySpecRepository, productRepository *repository.ProductRepository, elasticsearchUseCase *ElasticsearchUseCase) *CategoryUseCase {
+	return &CategoryUseCase{
+		DB:                     db,
+		Log:                    log,
+		Validate:               validate,
+		CategoryRepository:     categoryRepository,
+		CategorySpecRepository: categorySpecRepository,
+		ProductRepository:      productRepository,
+		ElasticsearchUseCase:   elasticsearchUseCase,
+	}
+}
+
+func (uc *CategoryUseCase) GetCategoryTree(ctx context.Context) ([]*model.CategoryResponse, error) {
+	categories, err := uc.CategoryRepository.GetAll(uc.DB.WithContext(ctx))
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to get categories")
+		return nil, utils.WrapMessageAsError(constants.FailedGetCategories, err)
+	}
+
+	roots, _ := buildCategoryTree(categories)
+	return roots, nil
+}
+
+func (uc *CategoryUseCase) GetCategoryByID(ctx context.Context, categoryID uuid.UUID) (*model.CategoryResponse, error) {
+	categories, err := uc.CategoryRepository.GetAll(uc.DB.WithContext(ctx))
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to get categories")
+		return nil, utils.WrapMessageAsError(constants.FailedGetCategoryByID, err)
+	}
+
+	_, nodes := buildCategoryTree(categories)
+	category, ok := nodes[categoryID]
+	if !ok {
+		return nil, utils.WrapMessageAsError(constants.CategoryNotFound)
+	}
+
+	return category, nil
+}
+
+func (uc *CategoryUseCase) CreateCategory(ctx context.Context, request *model.CategoryRequest) (*model.CategoryResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	category := &entity.Category{ID: uuid.New()}
+	if err := uc.applyCategoryRequest(tx, category, request); err != nil {
+		return nil, err
+	}
+
+	if err := uc.CategoryRepository.Create(tx, category); err != nil {
+		uc.Log.WithError(err).Error("Failed to create category")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateCategory, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for category creation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateCategory, err)
+	}
+
+	return converter.ToCategoryResponse(category), nil
+}
+
+func (uc *CategoryUseCase) UpdateCategory(ctx context.Context, categoryID uuid.UUID, request *model.CategoryRequest) (*model.CategoryResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	category, err := uc.CategoryRepository.FindCategoryById(tx, categoryID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find category by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetCategoryByID, err)
+	}
+
+	if category == nil {
+		return nil, utils.WrapMessageAsError(constants.CategoryNotFound)
+	}
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	if err := uc.applyCategoryRequest(tx, category, request); err != nil {
+		return nil, err
+	}
+
+	if err := uc.CategoryRepository.Update(tx, category); err != nil {
+		uc.Log.WithError(err).Error("Failed to update category")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateCategory, err)
+	}
+
+	productIDs, err := uc.CategoryRepository.FindProductIdsByCategoryId(tx, category.ID)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateCategory, err)
+	}
+
+	products, err := uc.refreshProductCategories(tx, productIDs)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateCategory, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for category update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateCategory, err)
+	}
+
+	uc.reindexProducts(products)
+
+	return converter.ToCategoryResponse(category), nil
+}
+
+func (uc *CategoryUseCase) DeleteCategory(ctx context.Context, categoryID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	category, err := uc.CategoryRepository.FindCategoryById(tx, categoryID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find category by ID")
+		return utils.WrapMessageAsError(constants.FailedGetCategoryByID, err)
+	}
+
+	if category == nil {
+		return utils.WrapMessageAsError(constants.CategoryNotFound)
+	}
+
+	children, err := uc.CategoryRepository.CountChildren(tx, category.ID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to count category children")
+		return utils.WrapMessageAsError(constants.FailedDeleteCategory, err)
+	}
+
+	if children > 0 {
+		return utils.WrapMessageAsError(constants.CategoryHasChildren)
+	}
+
+	productIDs, err := uc.CategoryRepository.FindProductIdsByCategoryId(tx, category.ID)
+	if err != nil {
+		return utils.WrapMessageAsError(constants.FailedDeleteCategory, err)
+	}
+
+	if err := uc.CategoryRepository.Delete(tx, category); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete category")
+		return utils.WrapMessageAsError(constants.FailedDeleteCategory, err)
+	}
+
+	products, err := uc.refreshProductCategories(tx, productIDs)
+	if err != nil {
+		return utils.WrapMessageAsError(constants.FailedDeleteCategory, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for category deletion")
+		return utils.WrapMessageAsError(constants.FailedDeleteCategory, err)
+	}
+
+	uc.reindexProducts(products)
+
+	return nil
+}
+
+func (uc *CategoryUseCase) ExpandCategorySlugs(ctx context.Context, slugs []string) ([]string, error) {
+	categories, err := uc.CategoryRepository.GetAll(uc.DB.WithContext(ctx))
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to get categories")
+		return nil, utils.WrapMessageAsError(constants.FailedGetCategories, err)
+	}
+
+	_, nodes := buildCategoryTree(categories)
+	bySlug := make(map[string]*model.CategoryResponse, len(nodes))
+	for _, node := range nodes {
+		bySlug[node.Slug] = node
+	}
+
+	seen := make(map[string]bool)
+	var expanded []string
+	var walk func(node *model.CategoryResponse)
+	walk = func(node *model.CategoryResponse) {
+		if seen[node.Slug] {
+			return
+		}
+		seen[node.Slug] = true
+		expanded = append(expanded, node.Slug)
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+
+	for _, slug := range slugs {
+		if node, ok := bySlug[slug]; ok {
+			walk(node)
+		} else if !seen[slug] {
+			seen[slug] = true
+			expanded = append(expanded, slug)
+		}
+	}
+
+	return expanded, nil
+}
+
+func (uc *CategoryUseCase) GetCategorySpecs(ctx context.Context, categoryID uuid.UUID) ([]*model.CategorySpecResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	category, err := uc.CategoryRepository.FindCategoryById(db, categoryID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find category by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetCategoryByID, err)
+	}
+
+	if category == nil {
+		return nil, utils.WrapMessageAsError(constants.CategoryNotFound)
+	}
+
+	specs, err := resolveSpecSchema(db, uc.CategoryRepository, uc.CategorySpecRepository, []uuid.UUID{category.ID})
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetCategorySpecs, err)
+	}
+
+	responses := make([]*model.CategorySpecResponse, 0, len(specs))
+	for i := range specs {
+		response := converter.ToCategorySpecResponse(&specs[i])
+		response.Inherited = specs[i].CategoryID != category.ID
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+func (uc *CategoryUseCase) ReplaceCategorySpecs(ctx context.Context, categoryID uuid.UUID, request *model.CategorySpecsRequest) ([]*model.CategorySpecResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	category, err := uc.CategoryRepository.FindCategoryById(tx, categoryID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find category by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetCategoryByID, err)
+	}
+
+	if category == nil {
+		return nil, utils.WrapMessageAsError(constants.CategoryNotFound)
+	}
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	seen := make(map[string]bool, len(request.Specs))
+	specs := make([]entity.CategorySpec, 0, len(request.Specs))
+	for i, item := range request.Specs {
+		key := strings.ReplaceAll(utils.Slugify(item.Key), "-", "_")
+		if key == "" || seen[key] {
+			return nil, utils.WrapMessageAsError(constants.DuplicateCategorySpecKey)
+		}
+		seen[key] = true
+
+		label, err := json.Marshal(item.Label)
+		if err != nil {
+			return nil, utils.WrapMessageAsError(constants.InvalidRequestData, err)
+		}
+
+		var allowedValues []byte
+		if len(item.AllowedValues) > 0 {
+			if allowedValues, err = json.Marshal(item.AllowedValues); err != nil {
+				return nil, utils.WrapMessageAsError(constants.InvalidRequestData, err)
+			}
+		}
+
+		specs = append(specs, entity.CategorySpec{
+			ID:            uuid.New(),
+			CategoryID:    category.ID,
+			Key:           key,
+			Label:         label,
+			Type:          item.Type,
+			Unit:          item.Unit,
+			AllowedValues: allowedValues,
+			Required:      item.Required,
+			Filterable:    item.Filterable,
+			Position:      i,
+		})
+	}
+
+	if err := uc.CategorySpecRepository.ReplaceSpecs(tx, category.ID, specs); err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateCategorySpecs, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for category specs update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateCategorySpecs, err)
+	}
+
+	return uc.GetCategorySpecs(ctx, category.ID)
+}
+
+func (uc *CategoryUseCase) applyCategoryRequest(tx *gorm.DB, category *entity.Category, request *model.CategoryRequest) error {
+	slug := utils.Slugify(request.Slug)
+	if slug == "" {
+		slug = utils.Slugify(request.Name["en"])
+	}
+	if slug == "" {
+		return utils.WrapMessageAsError(constants.InvalidCategorySlug)
+	}
+
+	total, err := uc.CategoryRepository.CountBySlug(tx, slug, category.ID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to count categories by slug")
+		return utils.WrapMessageAsError(constants.FailedCreateCategory, err)
+	}
+
+	if total > 0 {
+		return utils.WrapMessageAsError(constants.CategorySlugAlreadyExists)
+	}
+
+	if request.ParentID != nil {
+		categories, err := uc.CategoryRepository.GetAll(tx)
+		if err != nil {
+			return utils.WrapMessageAsError(constants.FailedGetCategories, err)
+		}
+
+		_, nodes := buildCategoryTree(categories)
+		if _, ok := nodes[*request.ParentID]; !ok {
+			return utils.WrapMessageAsError(constants.ParentCategoryNotFound)
+		}
+
+		if node, ok := nodes[category.ID]; ok && isCategoryInSubtree(node, *request.ParentID) {
+			return utils.WrapMessageAsError(constants.InvalidCategoryParent)
+		}
+	}
+
+	name, err := json.Marshal(request.Name)
+	if err != nil {
+		return utils.WrapMessageAsError(constants.InvalidRequestData, err)
+	}
+
+	category.ParentID = request.ParentID
+	category.Slug = slug
+	category.Name = name
+
+	return nil
+}
+
+func (uc *CategoryUseCase) refreshProductCategories(tx *gorm.DB, productIDs []uuid.UUID) ([]entity.Product, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	products, err := uc.ProductRepository.FindProductsByIds(tx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range products {
+		products[i].Category = converter.ToCategorySlugs(products[i].Categories)
+		if err := tx.Model(&products[i]).UpdateColumn("category", products[i].Category).Error; err != nil {
+			uc.Log.WithError(err).Error("Failed to refresh product categories")
+			return nil, err
+		}
+	}
+
+	return products, nil
+}
+
+func (uc *CategoryUseCase) reindexProducts(products []entity.Product) {
+	for i := range products {
+		if err := uc.ElasticsearchUseCase.InsertDocument(products[i].ID, &products[i]); err != nil {
+			uc.Log.WithError(err).Warnf("Failed to reindex product %s after category change", products[i].ID)
+		}
+	}
+}
+
+func resolveSpecSchema(db *gorm.DB, categoryRepository *repository.CategoryRepository, categorySpecRepository *repository.CategorySpecRepository, categoryIDs []uuid.UUID) ([]entity.CategorySpec, error) {
+	if len(categoryIDs) == 0 {
+		return nil, nil
+	}
+
+	categories, err := categoryRepository.GetAll(db)
+	if err != nil {
+		return nil, err
+	}
+
+	parents := make(map[uuid.UUID]*uuid.UUID, len(categories))
+	for _, category := range categories {
+		parents[category.ID] = category.ParentID
+	}
+
+	var chainIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, categoryID := range categoryIDs {
+		var chain []uuid.UUID
+		for current := &categoryID; current != nil && !slices.Contains(chain, *current); current = parents[*current] {
+			chain = append([]uuid.UUID{*current}, chain...)
+		}
+		for _, id := range chain {
+			if !seen[id] {
+				seen[id] = true
+				chainIDs = append(chainIDs, id)
+			}
+		}
+	}
+
+	specs, err := categorySpecRepository.FindSpecsByCategoryIds(db, chainIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategory := make(map[uuid.UUID][]entity.CategorySpec)
+	for _, spec := range specs {
+		byCategory[spec.CategoryID] = append(byCategory[spec.CategoryID], spec)
+	}
+
+	var schema []entity.CategorySpec
+	positions := make(map[string]int)
+	for _, id := range chainIDs {
+		for _, spec := range byCategory[id] {
+			if index, ok := positions[spec.Key]; ok {
+				schema[index] = spec
+				continue
+			}
+			positions[spec.Key] = len(schema)
+			schema = append(schema, spec)
+		}
+	}
+
+	return schema, nil
+}
+
+func buildCategoryTree(categories []entity.Category) ([]*model.CategoryResponse, map[uuid.UUID]*model.CategoryResponse) {
+	nodes := make(map[uuid.UUID]*model.CategoryResponse, len(categories))
+	for i := range categories {
+		nodes[categories[i].ID] = converter.ToCategoryResponse(&categories[i])
+	}
+
+	var roots []*model.CategoryResponse
+	for i := range categories {
+		node := nodes[categories[i].ID]
+		if node.ParentID == nil {
+			roots = append(roots, node)
+			continue
+		}
+
+		if parent, ok := nodes[*node.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	return roots, nodes
+}
+
+func isCategoryInSubtree(node *model.CategoryResponse, categoryID uuid.UUID) bool {
+	if node.ID == categoryID {
+		return true
+	}
+
+	for _, child := range node.Children {
+		if isCategoryInSubtree(child, categoryID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func collectCategorySubtree(node *model.CategoryResponse) []uuid.UUID {
+	ids := []uuid.UUID{node.ID}
+	for _, child := range node.Children {
+		ids = append(ids, collectCategorySubtree(child)...)
+	}
+	return ids
+}
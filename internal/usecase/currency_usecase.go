@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type CurrencyUseCase struct {
+	DB                     *gorm.DB
+	Log                    *logrus.Logger
+	Validate               *validator.Validate
+	ExchangeRateRepository *repository.ExchangeRateRepository
+}
+
+func NewCurrencyUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, exchangeRateRepository *repository.ExchangeRateRepository) *CurrencyUseCase {
+	return &CurrencyUseCase{
+		DB:                     db,
+		Log:                    log,
+		Validate:               validate,
+		ExchangeRateRepository: exchangeRateRepository,
+	}
+}
+
+func (uc *CurrencyUseCase) GetExchangeRates(ctx context.Context) ([]*model.ExchangeRateResponse, error) {
+	rates, err := uc.ExchangeRateRepository.GetAll(uc.DB.WithContext(ctx))
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetExchangeRates, err)
+	}
+
+	responses := make([]*model.ExchangeRateResponse, 0, len(rates))
+	for i := range rates {
+		responses = append(responses, converter.ToExchangeRateResponse(&rates[i]))
+	}
+
+	return responses, nil
+}
+
+func (uc *CurrencyUseCase) UpdateExchangeRates(ctx context.Context, request *model.UpdateExchangeRatesRequest, actorID uuid.UUID) ([]*model.ExchangeRateResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	existing, err := uc.ExchangeRateRepository.GetAll(tx)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateExchangeRates, err)
+	}
+
+	rates := make(map[string]*entity.ExchangeRate, len(existing))
+	for i := range existing {
+		rates[existing[i].Currency] = &existing[i]
+	}
+
+	for _, item := range request.Rates {
+		rate, ok := rates[item.Currency]
+		if !ok {
+			rate = &entity.ExchangeRate{Currency: item.Currency, Rate: item.Rate, UpdatedBy: actorID}
+			if err := uc.ExchangeRateRepository.Create(tx, rate); err != nil {
+				uc.Log.WithError(err).Error("Failed to create exchange rate")
+				return nil, utils.WrapMessageAsError(constants.FailedUpdateExchangeRates, err)
+			}
+			continue
+		}
+
+		rate.Rate = item.Rate
+		rate.UpdatedBy = actorID
+		if err := uc.ExchangeRateRepository.Update(tx, rate); err != nil {
+			uc.Log.WithError(err).Error("Failed to update exchange rate")
+			return nil, utils.WrapMessageAsError(constants.FailedUpdateExchangeRates, err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for exchange rate update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateExchangeRates, err)
+	}
+
+	return uc.GetExchangeRates(ctx)
+}
+
+func (uc *CurrencyUseCase) ApplyProductPricing(ctx context.Context, currency string, products ...*model.ProductResponse) error {
+	sources := make([]string, 0, len(products))
+	for _, product := range products {
+		sources = append(sources, converter.SourceCurrency(product.Currency))
+	}
+
+	rates, err := uc.ratesFor(ctx, append(sources, currency))
+	if err != nil {
+		return utils.WrapMessageAsError(constants.FailedConvertCurrency, err)
+	}
+	if rates[currency] <= 0 {
+		return utils.WrapMessageWithStatus(http.StatusBadRequest, constants.ExchangeRateUnavailable)
+	}
+
+	for i, product := range products {
+		rate, target := uc.crossRate(rates, sources[i], currency)
+		product.Pricing = converter.ToProductPricing(product, rate, target)
+	}
+
+	return nil
+}
+
+func (uc *CurrencyUseCase) ApplyDocumentPricing(ctx context.Context, currency string, documents []map[string]any) error {
+	sources := make([]string, 0, len(documents))
+	for _, document := range documents {
+		sources = append(sources, converter.DocumentCurrency(document))
+	}
+
+	rates, err := uc.ratesFor(ctx, append(sources, currency))
+	if err != nil {
+		return utils.WrapMessageAsError(constants.FailedConvertCurrency, err)
+	}
+	if rates[currency] <= 0 {
+		return utils.WrapMessageWithStatus(http.StatusBadRequest, constants.ExchangeRateUnavailable)
+	}
+
+	for i, document := range documents {
+		rate, target := uc.crossRate(rates, sources[i], currency)
+		document["pricing"] = converter.ToDocumentPricing(document, rate, target)
+	}
+
+	return nil
+}
+
+func (uc *CurrencyUseCase) ratesFor(ctx context.Context, currencies []string) (map[string]float64, error) {
+	rates := map[string]float64{model.BaseCurrency: 1}
+
+	var foreign []string
+	for _, currency := range currencies {
+		if _, ok := rates[currency]; !ok {
+			rates[currency] = 0
+			foreign = append(foreign, currency)
+		}
+	}
+
+	if len(foreign) == 0 {
+		return rates, nil
+	}
+
+	found, err := uc.ExchangeRateRepository.FindByCurrencies(uc.DB.WithContext(ctx), foreign)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, currency := range foreign {
+		rates[currency] = found[currency]
+	}
+
+	return rates, nil
+}
+
+// crossRate returns the rate from source to target. The target rate is
+// checked by the callers; a product priced in a currency without a rate is
+// presented in its own currency rather than failing the whole listing.
+func (uc *CurrencyUseCase) crossRate(rates map[string]float64, source, target string) (float64, string) {
+	if source == target {
+		return 1, target
+	}
+
+	if rates[source] <= 0 {
+		uc.Log.WithField("source", source).WithField("target", target).Warn("Missing exchange rate for product currency")
+		return 1, source
+	}
+
+	return rates[target] / rates[source], target
+}
@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type FlashSaleUseCase struct {
+	DB                       *gorm.DB
+	Log                      *logrus.Logger
+	Validate                 *validator.Validate
+	FlashSaleRepository      *repository.FlashSaleRepository
+	FlashSaleQuotaRepository *repository.FlashSaleQuotaRepository
+	ProductRepository        *repository.ProductRepository
+}
+
+func NewFlashSaleUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, flashSaleRepository *repository.FlashSaleRepository, flashSaleQuotaRepository *repository.FlashSaleQuotaRepository, productRepository *repository.ProductRepository) *FlashSaleUseCase {
+	return &FlashSaleUseCase{
+		DB:                       db,
+		Log:                      log,
+		Validate:                 validate,
+		FlashSaleRepository:      flashSaleRepository,
+		FlashSaleQuotaRepository: flashSaleQuotaRepository,
+		ProductRepository:        productRepository,
+	}
+}
+
+func (uc *FlashSaleUseCase) GetFlashSales(ctx context.Context) ([]*model.FlashSaleResponse, error) {
+	sales, err := uc.FlashSaleRepository.GetAll(uc.DB.WithContext(ctx))
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetFlashSales, err)
+	}
+
+	responses := make([]*model.FlashSaleResponse, 0, len(sales))
+	for i := range sales {
+		remaining := flashSaleRemaining(ctx, uc.Log, uc.FlashSaleQuotaRepository, &sales[i])
+		responses = append(responses, converter.ToFlashSaleResponse(&sales[i], remaining))
+	}
+
+	return responses, nil
+}
+
+func (uc *FlashSaleUseCase) GetFlashSaleByID(ctx context.Context, saleID uuid.UUID) (*model.FlashSaleResponse, error) {
+	sale, err := uc.FlashSaleRepository.FindFlashSaleById(uc.DB.WithContext(ctx), saleID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find flash sale by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetFlashSaleByID, err)
+	}
+
+	if sale == nil {
+		return nil, utils.WrapMessageAsError(constants.FlashSaleNotFound)
+	}
+
+	remaining := flashSaleRemaining(ctx, uc.Log, uc.FlashSaleQuotaRepository, sale)
+	return converter.ToFlashSaleResponse(sale, remaining), nil
+}
+
+func (uc *FlashSaleUseCase) CreateFlashSale(ctx context.Context, request *model.FlashSaleRequest, actorID uuid.UUID) (*model.FlashSaleResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	sale := &entity.FlashSale{ID: uuid.New(), CreatedBy: actorID}
+	if err := uc.applyFlashSaleRequest(tx, sale, request); err != nil {
+		return nil, err
+	}
+
+	if err := uc.FlashSaleRepository.Create(tx, sale); err != nil {
+		uc.Log.WithError(err).Error("Failed to create flash sale")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateFlashSale, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for flash sale creation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateFlashSale, err)
+	}
+
+	if err := uc.FlashSaleQuotaRepository.Init(ctx, sale.ID, sale.Quota, flashSaleTTL(sale)); err != nil {
+		uc.Log.WithError(err).Warn("Failed to initialize flash sale quota in Redis")
+	}
+
+	return converter.ToFlashSaleResponse(sale, sale.Quota), nil
+}
+
+func (uc *FlashSaleUseCase) UpdateFlashSale(ctx context.Context, saleID uuid.UUID, request *model.FlashSaleRequest) (*model.FlashSaleResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	sale, err := uc.FlashSaleRepository.FindFlashSaleById(tx, saleID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find flash sale by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetFlashSaleByID, err)
+	}
+
+	if sale == nil {
+		return nil, utils.WrapMessageAsError(constants.FlashSaleNotFound)
+	}
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	if request.Quota < sale.Sold {
+		return nil, utils.WrapMessageAsError(constants.FlashSaleQuotaBelowSold)
+	}
+
+	previousQuota := sale.Quota
+	if err := uc.applyFlashSaleRequest(tx, sale, request); err != nil {
+		return nil, err
+	}
+
+	if err := uc.FlashSaleRepository.Update(tx, sale); err != nil {
+		uc.Log.WithError(err).Error("Failed to update flash sale")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateFlashSale, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for flash sale update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateFlashSale, err)
+	}
+
+	if err := uc.FlashSaleQuotaRepository.Adjust(ctx, sale.ID, sale.Quota-previousQuota, flashSaleTTL(sale)); err != nil {
+		uc.Log.WithError(err).Warn("Failed to adjust flash sale quota in Redis")
+	}
+
+	remaining := flashSaleRemaining(ctx, uc.Log, uc.FlashSaleQuotaRepository, sale)
+	return converter.ToFlashSaleResponse(sale, remaining), nil
+}
+
+func (uc *FlashSaleUseCase) DeleteFlashSale(ctx context.Context, saleID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	sale, err := uc.FlashSaleRepository.FindFlashSaleById(tx, saleID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find flash sale by ID")
+		return utils.WrapMessageAsError(constants.FailedGetFlashSaleByID, err)
+	}
+
+	if sale == nil {
+		return utils.WrapMessageAsError(constants.FlashSaleNotFound)
+	}
+
+	if err := uc.FlashSaleRepository.Delete(tx, sale); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete flash sale")
+		return utils.WrapMessageAsError(constants.FailedDeleteFlashSale, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for flash sale deletion")
+		return utils.WrapMessageAsError(constants.FailedDeleteFlashSale, err)
+	}
+
+	if err := uc.FlashSaleQuotaRepository.Delete(ctx, sale.ID); err != nil {
+		uc.Log.WithError(err).Warn("Failed to delete flash sale quota from Redis")
+	}
+
+	return nil
+}
+
+func (uc *FlashSaleUseCase) applyFlashSaleRequest(tx *gorm.DB, sale *entity.FlashSale, request *model.FlashSaleRequest) error {
+	total, err := uc.ProductRepository.CountById(tx, request.ProductID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to count product by ID")
+		return utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+
+	if total == 0 {
+		return utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	active := request.Active == nil || *request.Active
+	if active {
+		overlapping, err := uc.FlashSaleRepository.CountOverlapping(tx, request.ProductID, request.StartsAt, request.EndsAt, sale.ID)
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to count overlapping flash sales")
+			return utils.WrapMessageAsError(constants.FailedCreateFlashSale, err)
+		}
+
+		if overlapping > 0 {
+			return utils.WrapMessageAsError(constants.FlashSaleOverlaps)
+		}
+	}
+
+	sale.Name = strings.TrimSpace(request.Name)
+	sale.ProductID = request.ProductID
+	sale.SalePrice = request.SalePrice
+	sale.Quota = request.Quota
+	sale.PerUserLimit = request.PerUserLimit
+	sale.StartsAt = request.StartsAt
+	sale.EndsAt = request.EndsAt
+	sale.Active = active
+
+	return nil
+}
+
+func flashSaleRemaining(ctx context.Context, log *logrus.Logger, flashSaleQuotaRepository *repository.FlashSaleQuotaRepository, sale *entity.FlashSale) int {
+	remaining, ok, err := flashSaleQuotaRepository.Remaining(ctx, sale.ID)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to read flash sale %s quota from Redis", sale.ID)
+	}
+
+	if err != nil || !ok {
+		remaining = sale.Quota - sale.Sold
+	}
+
+	return max(remaining, 0)
+}
+
+func flashSaleTTL(sale *entity.FlashSale) time.Duration {
+	return max(time.Until(sale.EndsAt), 0) + 24*time.Hour
+}
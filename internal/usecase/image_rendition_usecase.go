@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/model"
+	"golectro-product/internal/utils"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"golang.org/x/image/draw"
+)
+
+type ImageRenditionUseCase struct {
+	Log          *logrus.Logger
+	Validate     *validator.Validate
+	Viper        *viper.Viper
+	MinioUseCase *MinioUseCase
+}
+
+func NewImageRenditionUsecase(log *logrus.Logger, validate *validator.Validate, viper *viper.Viper, minioUseCase *MinioUseCase) *ImageRenditionUseCase {
+	return &ImageRenditionUseCase{
+		Log:          log,
+		Validate:     validate,
+		Viper:        viper,
+		MinioUseCase: minioUseCase,
+	}
+}
+
+func (uc *ImageRenditionUseCase) GetRendition(ctx context.Context, objectKey string, request *model.ImagePreviewRequest) (*model.ImageRendition, error) {
+	if err := uc.Validate.Struct(request); err != nil {
+		uc.Log.WithError(err).Error("Invalid image preview request")
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	size := request.Size
+	if size == "" {
+		size = model.ImageSizeOriginal
+	}
+	format := request.Format
+	if format == "" {
+		format = model.ImageFormatOriginal
+	}
+
+	bucket := uc.Viper.GetString("MINIO_BUCKET_PRODUCT")
+	if size == model.ImageSizeOriginal && format == model.ImageFormatOriginal {
+		return uc.statRendition(ctx, bucket, objectKey)
+	}
+
+	renditionKey := renditionObjectKey(objectKey, size, format)
+	rendition, err := uc.statRendition(ctx, bucket, renditionKey)
+	if err != nil {
+		return nil, err
+	}
+	if rendition != nil {
+		return rendition, nil
+	}
+
+	if err := uc.generateRendition(ctx, bucket, objectKey, renditionKey, size, format); err != nil {
+		return nil, err
+	}
+
+	return uc.statRendition(ctx, bucket, renditionKey)
+}
+
+func (uc *ImageRenditionUseCase) DeleteRenditions(ctx context.Context, objectKey string) error {
+	bucket := uc.Viper.GetString("MINIO_BUCKET_PRODUCT")
+	for _, key := range renditionObjectKeys(objectKey) {
+		if err := uc.MinioUseCase.Delete(ctx, bucket, key); err != nil {
+			uc.Log.WithError(err).Errorf("Failed to delete image rendition %s", key)
+			return utils.WrapMessageAsError(constants.FailedDeleteImageFromMinio, err)
+		}
+	}
+	return nil
+}
+
+func (uc *ImageRenditionUseCase) statRendition(ctx context.Context, bucket, objectKey string) (*model.ImageRendition, error) {
+	info, err := uc.MinioUseCase.StatObject(ctx, bucket, objectKey)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to get object info from Minio")
+		return nil, utils.WrapMessageAsError(constants.FailedGetPresignedURL, err)
+	}
+	if info == nil {
+		return nil, nil
+	}
+
+	return &model.ImageRendition{
+		ObjectKey:    objectKey,
+		ContentType:  info.ContentType,
+		Size:         info.Size,
+		ETag:         info.ETag,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+func (uc *ImageRenditionUseCase) generateRendition(ctx context.Context, bucket, objectKey, renditionKey, size, format string) error {
+	object, err := uc.MinioUseCase.GetObject(ctx, bucket, objectKey)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to get object from Minio")
+		return utils.WrapMessageAsError(constants.FailedGenerateRendition, err)
+	}
+	defer object.Close()
+
+	content, err := io.ReadAll(object)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to read object from Minio")
+		return utils.WrapMessageAsError(constants.FailedGenerateRendition, err)
+	}
+
+	if _, _, err := utils.CheckImagePixels(content, utils.DefaultMaxImagePixels); err != nil {
+		uc.Log.WithError(err).Errorf("Refused to decode image %s", objectKey)
+		return utils.WrapMessageAsError(constants.FailedDecodeImage)
+	}
+
+	src, sourceFormat, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to decode image")
+		return utils.WrapMessageAsError(constants.FailedDecodeImage, err)
+	}
+
+	if maxSide, ok := model.ImageRenditionSizes[size]; ok {
+		src = resizeImage(src, maxSide)
+	}
+
+	var buf bytes.Buffer
+	contentType, err := encodeImage(&buf, src, sourceFormat, format)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to encode image rendition")
+		return utils.WrapMessageAsError(constants.FailedGenerateRendition, err)
+	}
+
+	if err := uc.MinioUseCase.Upload(ctx, model.UploadFileInput{
+		Bucket:      bucket,
+		ObjectKey:   renditionKey,
+		Content:     buf.Bytes(),
+		ContentType: contentType,
+	}); err != nil {
+		uc.Log.WithError(err).Error("Failed to upload image rendition")
+		return utils.WrapMessageAsError(constants.FailedUploadObject, err)
+	}
+
+	uc.Log.Infof("Generated image rendition %s", renditionKey)
+	return nil
+}
+
+func resizeImage(src image.Image, maxSide int) image.Image {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSide && height <= maxSide {
+		return src
+	}
+
+	if width >= height {
+		height = max(1, height*maxSide/width)
+		width = maxSide
+	} else {
+		width = max(1, width*maxSide/height)
+		height = maxSide
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+	return dst
+}
+
+func encodeImage(w io.Writer, img image.Image, sourceFormat, format string) (string, error) {
+	if format == model.ImageFormatWebP {
+		return "image/webp", nativewebp.Encode(w, img, nil)
+	}
+
+	switch sourceFormat {
+	case "jpeg":
+		return "image/jpeg", jpeg.Encode(w, img, &jpeg.Options{Quality: 85})
+	case "gif":
+		return "image/gif", gif.Encode(w, img, nil)
+	default:
+		return "image/png", png.Encode(w, img)
+	}
+}
+
+func renditionObjectKey(objectKey, size, format string) string {
+	name := objectKey
+	if format == model.ImageFormatWebP {
+		name = strings.TrimSuffix(objectKey, path.Ext(objectKey)) + ".webp"
+	}
+	return fmt.Sprintf("%s%s/%s/%s", model.ImageRenditionPrefix, size, format, name)
+}
+
+func renditionSourceStem(renditionKey string) (string, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(renditionKey, model.ImageRenditionPrefix), "/", 3)
+	if len(parts) != 3 {
+		return "", false
+	}
+	return strings.TrimSuffix(parts[2], path.Ext(parts[2])), true
+}
+
+func renditionObjectKeys(objectKey string) []string {
+	sizes := []string{model.ImageSizeOriginal}
+	for size := range model.ImageRenditionSizes {
+		sizes = append(sizes, size)
+	}
+
+	keys := make([]string, 0, len(sizes)*len(model.ImageRenditionFormats))
+	for _, size := range sizes {
+		for _, format := range model.ImageRenditionFormats {
+			if size == model.ImageSizeOriginal && format == model.ImageFormatOriginal {
+				continue
+			}
+			keys = append(keys, renditionObjectKey(objectKey, size, format))
+		}
+	}
+	return keys
+}
@@ -1,14 +1,28 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"golectro-product/internal/constants"
 	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
 	"golectro-product/internal/repository"
 	"golectro-product/internal/utils"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/spf13/viper"
 
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -18,17 +32,33 @@ type ImageUseCase struct {
 	DB                   *gorm.DB
 	Log                  *logrus.Logger
 	Validate             *validator.Validate
+	Viper                *viper.Viper
 	ImageRepository      *repository.ImageRepository
+	ProductRepository    *repository.ProductRepository
+	UploadRepository     *repository.ImageUploadRepository
+	MinioUseCase         *MinioUseCase
 	ElasticsearchUseCase *ElasticsearchUseCase
+	FileScanner          repository.FileScanner
 }
 
-func NewImageUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, productRepository *repository.ImageRepository, elasticsearchUseCase *ElasticsearchUseCase) *ImageUseCase {
+var (
+	errUploadInfected    = errors.New("upload was flagged by the malware scanner")
+	errUploadScanFailure = errors.New("upload could not be scanned")
+	errUploadMismatch    = errors.New("upload does not match its declaration")
+)
+
+func NewImageUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, viper *viper.Viper, imageRepository *repository.ImageRepository, productRepository *repository.ProductRepository, uploadRepository *repository.ImageUploadRepository, minioUseCase *MinioUseCase, elasticsearchUseCase *ElasticsearchUseCase, fileScanner repository.FileScanner) *ImageUseCase {
 	return &ImageUseCase{
 		DB:                   db,
 		Log:                  log,
 		Validate:             validate,
-		ImageRepository:      productRepository,
+		Viper:                viper,
+		ImageRepository:      imageRepository,
+		ProductRepository:    productRepository,
+		UploadRepository:     uploadRepository,
+		MinioUseCase:         minioUseCase,
 		ElasticsearchUseCase: elasticsearchUseCase,
+		FileScanner:          fileScanner,
 	}
 }
 
@@ -49,20 +79,803 @@ func (uc *ImageUseCase) GetImageByID(ctx context.Context, imageID uuid.UUID) (*e
 	return image, nil
 }
 
-func (uc *ImageUseCase) DeleteImage(ctx context.Context, imageID *entity.ProductImage) error {
+func (uc *ImageUseCase) UpdateImage(ctx context.Context, imageID uuid.UUID, request *model.ProductImageRequest, locale string) (*model.ProductImageResponse, error) {
+	if err := uc.Validate.Struct(request); err != nil {
+		uc.Log.WithError(err).Error("Invalid product image request")
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	image, err := uc.ImageRepository.FindImageById(tx, imageID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find image by ID")
+		return nil, utils.WrapMessageAsError(constants.ImageNotFound)
+	}
+
+	if request.IsPrimary && !image.IsPrimary {
+		if err := uc.ImageRepository.ClearPrimary(tx, image.ProductID); err != nil {
+			uc.Log.WithError(err).Error("Failed to clear primary image")
+			return nil, utils.WrapMessageAsError(constants.FailedUpdateProductImage, err)
+		}
+		image.IsPrimary = true
+	}
+
+	image.AltText = request.AltText
+	image.Caption = request.Caption
+	image.Translations = converter.ToImageTranslations(request.AltText, request.Caption, request.Translations)
+
+	if err := uc.ImageRepository.Update(tx.Omit("Product"), image); err != nil {
+		uc.Log.WithError(err).Error("Failed to update image")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductImage, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction")
+		return nil, utils.WrapMessageAsError(constants.FailedCommitTransaction, err)
+	}
+
+	uc.reindexProduct(ctx, image.ProductID)
+
+	response := converter.ToProductImageResponse(image, locale)
+	uc.signImageURLs(ctx, []*model.ProductImageResponse{response})
+	return response, nil
+}
+
+func (uc *ImageUseCase) ReorderImages(ctx context.Context, productID uuid.UUID, request *model.ImageOrderRequest, locale string) ([]*model.ProductImageResponse, error) {
+	if err := uc.Validate.Struct(request); err != nil {
+		uc.Log.WithError(err).Error("Invalid image order request")
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	product, err := uc.ProductRepository.FindProductById(tx, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+	if product == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	images, err := uc.ImageRepository.FindByProductId(tx, productID)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductImages, err)
+	}
+
+	if len(images) != len(request.ImageIDs) {
+		return nil, utils.WrapMessageAsError(constants.ImageOrderMismatch)
+	}
+
+	positions := make(map[uuid.UUID]int, len(request.ImageIDs))
+	for i, id := range request.ImageIDs {
+		positions[id] = i
+	}
+
+	primaryID := uuid.Nil
+	for _, image := range images {
+		if _, ok := positions[image.ID]; !ok {
+			return nil, utils.WrapMessageAsError(constants.ImageOrderMismatch)
+		}
+		if image.IsPrimary {
+			primaryID = image.ID
+		}
+	}
+
+	if request.PrimaryImageID != nil {
+		if _, ok := positions[*request.PrimaryImageID]; !ok {
+			return nil, utils.WrapMessageAsError(constants.PrimaryImageNotInOrder)
+		}
+		primaryID = *request.PrimaryImageID
+	}
+	if primaryID == uuid.Nil {
+		primaryID = request.ImageIDs[0]
+	}
+
+	for i := range images {
+		images[i].Position = positions[images[i].ID]
+		images[i].IsPrimary = images[i].ID == primaryID
+		if err := uc.ImageRepository.Update(tx.Omit("Product"), &images[i]); err != nil {
+			uc.Log.WithError(err).Error("Failed to update image position")
+			return nil, utils.WrapMessageAsError(constants.FailedReorderProductImages, err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction")
+		return nil, utils.WrapMessageAsError(constants.FailedCommitTransaction, err)
+	}
+
+	uc.reindexProduct(ctx, productID)
+
+	slices.SortFunc(images, func(a, b entity.ProductImage) int {
+		return a.Position - b.Position
+	})
+
+	responses := converter.ToProductImageResponses(images, locale)
+	uc.signImageURLs(ctx, responses)
+	return responses, nil
+}
+
+func (uc *ImageUseCase) DeleteImage(ctx context.Context, image *entity.ProductImage) error {
 	tx := uc.DB.WithContext(ctx).Begin()
 	defer tx.Rollback()
 
-	if err := uc.ImageRepository.Delete(tx, imageID); err != nil {
+	if err := uc.ImageRepository.Delete(tx, image); err != nil {
 		uc.Log.WithError(err).Error("Failed to delete image")
 		return utils.WrapMessageAsError(constants.FailedDeleteImage, err)
 	}
 
+	if image.IsPrimary {
+		remaining, err := uc.ImageRepository.FindByProductId(tx, image.ProductID)
+		if err != nil {
+			return utils.WrapMessageAsError(constants.FailedDeleteImage, err)
+		}
+		if len(remaining) > 0 {
+			remaining[0].IsPrimary = true
+			if err := uc.ImageRepository.Update(tx.Omit("Product"), &remaining[0]); err != nil {
+				uc.Log.WithError(err).Error("Failed to promote primary image")
+				return utils.WrapMessageAsError(constants.FailedDeleteImage, err)
+			}
+		}
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		uc.Log.WithError(err).Error("Failed to commit transaction")
 		return utils.WrapMessageAsError(constants.FailedCommitTransaction, err)
 	}
 
+	uc.reindexProduct(ctx, image.ProductID)
+
 	uc.Log.Info("Image deleted successfully")
 	return nil
 }
+
+func (uc *ImageUseCase) CreateUploadURLs(ctx context.Context, productID uuid.UUID, request *model.ImageUploadURLRequest, userID uuid.UUID) ([]*model.ImageUploadURLResponse, error) {
+	if err := uc.Validate.Struct(request); err != nil {
+		uc.Log.WithError(err).Error("Invalid image upload request")
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	product, err := uc.ProductRepository.FindProductById(tx, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+	if product == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	expiresAt := time.Now().Add(model.ImageUploadURLExpiry)
+	responses := make([]*model.ImageUploadURLResponse, 0, len(request.Files))
+	for _, file := range request.Files {
+		upload := &entity.ProductImageUpload{
+			ID:             uuid.New(),
+			ProductID:      productID,
+			OriginalName:   file.FileName,
+			ContentType:    file.ContentType,
+			Size:           file.Size,
+			ChecksumSHA256: strings.ToLower(file.ChecksumSHA256),
+			Status:         model.ImageUploadStatusPending,
+			ExpiresAt:      expiresAt,
+			CreatedBy:      userID,
+		}
+		upload.ObjectKey = fmt.Sprintf("%v-%s%s", userID, upload.ID, model.ImageUploadExtensions[file.ContentType])
+
+		if err := uc.UploadRepository.Create(tx.Omit("Product"), upload); err != nil {
+			uc.Log.WithError(err).Error("Failed to create image upload")
+			return nil, utils.WrapMessageAsError(constants.FailedCreateImageUpload, err)
+		}
+
+		url, err := uc.MinioUseCase.GetPresignedPutURL(ctx, model.PresignedURLInput{
+			Bucket:    uc.Viper.GetString("MINIO_BUCKET_STAGING"),
+			ObjectKey: upload.ObjectKey,
+			Expiry:    int64(model.ImageUploadURLExpiry.Seconds()),
+		})
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to get presigned upload URL")
+			return nil, utils.WrapMessageAsError(constants.FailedGetPresignedURL, err)
+		}
+
+		responses = append(responses, &model.ImageUploadURLResponse{
+			UploadID:  upload.ID,
+			ObjectKey: upload.ObjectKey,
+			UploadURL: url,
+			Method:    http.MethodPut,
+			Headers:   map[string]string{"Content-Type": upload.ContentType},
+			ExpiresAt: expiresAt,
+		})
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction")
+		return nil, utils.WrapMessageAsError(constants.FailedCommitTransaction, err)
+	}
+
+	return responses, nil
+}
+
+func (uc *ImageUseCase) ConfirmUploads(ctx context.Context, productID uuid.UUID, request *model.ImageUploadConfirmRequest, locale string) (*model.ImageUploadConfirmResponse, error) {
+	if err := uc.Validate.Struct(request); err != nil {
+		uc.Log.WithError(err).Error("Invalid image upload confirmation")
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	uploads, err := uc.UploadRepository.FindByIds(uc.DB.WithContext(ctx), productID, request.UploadIDs)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedConfirmImageUpload, err)
+	}
+	if len(uploads) != len(request.UploadIDs) {
+		return nil, utils.WrapMessageAsError(constants.ImageUploadNotFound)
+	}
+
+	now := time.Now()
+	for _, upload := range uploads {
+		if upload.Status != model.ImageUploadStatusPending {
+			return nil, utils.WrapMessageAsError(constants.ImageUploadNotPending)
+		}
+		if now.After(upload.ExpiresAt) {
+			return nil, utils.WrapMessageAsError(constants.ImageUploadExpired)
+		}
+	}
+
+	var rejected []entity.ProductImageUpload
+	infected := false
+	verified := make([]*utils.SanitizedImage, 0, len(uploads))
+	for _, upload := range uploads {
+		image, err := uc.verifyUpload(ctx, &upload)
+		if errors.Is(err, errUploadScanFailure) {
+			uc.Log.WithError(err).Errorf("Failed to scan image upload %s", upload.ID)
+			return nil, utils.WrapMessageWithStatus(http.StatusServiceUnavailable, constants.FailedScanFile)
+		}
+		if errors.Is(err, errUploadMismatch) || errors.Is(err, errUploadInfected) {
+			uc.Log.WithError(err).Warnf("Rejected image upload %s", upload.ID)
+			infected = infected || errors.Is(err, errUploadInfected)
+			rejected = append(rejected, upload)
+			continue
+		}
+		if err != nil {
+			uc.Log.WithError(err).Errorf("Failed to verify image upload %s", upload.ID)
+			return nil, utils.WrapMessageWithStatus(http.StatusServiceUnavailable, constants.ImageStorageUnavailable)
+		}
+		verified = append(verified, image)
+	}
+
+	if len(rejected) > 0 {
+		uc.rejectUploads(ctx, rejected)
+		if infected {
+			return nil, utils.WrapMessageAsError(constants.FileInfected)
+		}
+		return nil, utils.WrapMessageAsError(constants.ImageUploadVerificationFailed)
+	}
+
+	bucket := uc.Viper.GetString("MINIO_BUCKET_PRODUCT")
+	committed := false
+	published := make([]model.UploadedImage, 0, len(verified))
+	defer func() {
+		if committed {
+			return
+		}
+		for _, image := range published {
+			if err := uc.MinioUseCase.Delete(ctx, bucket, image.ObjectKey); err != nil {
+				uc.Log.WithError(err).Warnf("Failed to delete unregistered image %s", image.ObjectKey)
+			}
+		}
+	}()
+
+	for i, image := range verified {
+		if err := uc.MinioUseCase.Upload(ctx, model.UploadFileInput{
+			Bucket:      bucket,
+			ObjectKey:   uploads[i].ObjectKey,
+			Content:     image.Content,
+			ContentType: image.ContentType,
+		}); err != nil {
+			uc.Log.WithError(err).Errorf("Failed to publish image upload %s", uploads[i].ID)
+			return nil, utils.WrapMessageWithStatus(http.StatusServiceUnavailable, constants.ImageStorageUnavailable)
+		}
+
+		published = append(published, model.UploadedImage{
+			ObjectKey:      uploads[i].ObjectKey,
+			Width:          image.Width,
+			Height:         image.Height,
+			ContentHash:    image.ContentHash,
+			PerceptualHash: &image.PerceptualHash,
+		})
+	}
+
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	product, err := uc.ProductRepository.FindProductById(tx, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+	if product == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	confirmed, err := uc.UploadRepository.ConfirmPending(tx, request.UploadIDs)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedConfirmImageUpload, err)
+	}
+	if confirmed != int64(len(uploads)) {
+		return nil, utils.WrapMessageWithStatus(http.StatusConflict, constants.ImageUploadNotPending)
+	}
+
+	images, warnings, err := createProductImages(tx, uc.ProductRepository, uc.ImageRepository, product, published)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to create product image record")
+		return nil, utils.WrapMessageAsError(constants.FailedConfirmImageUpload, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction")
+		return nil, utils.WrapMessageAsError(constants.FailedCommitTransaction, err)
+	}
+	committed = true
+
+	staging := uc.Viper.GetString("MINIO_BUCKET_STAGING")
+	for _, upload := range uploads {
+		if err := uc.MinioUseCase.Delete(ctx, staging, upload.ObjectKey); err != nil {
+			uc.Log.WithError(err).Warnf("Failed to delete staged upload %s", upload.ObjectKey)
+		}
+	}
+
+	uc.reindexProduct(ctx, productID)
+
+	responses := converter.ToProductImageResponses(images, locale)
+	uc.signImageURLs(ctx, responses)
+	return &model.ImageUploadConfirmResponse{
+		Images:   responses,
+		Warnings: warnings,
+	}, nil
+}
+
+// verifyUpload checks an object in the private staging bucket and returns
+// the sanitized image once it has passed the scan. Publishing it to the
+// product bucket is left to the caller so that nothing becomes public before
+// the whole batch has been verified.
+func (uc *ImageUseCase) verifyUpload(ctx context.Context, upload *entity.ProductImageUpload) (*utils.SanitizedImage, error) {
+	staging := uc.Viper.GetString("MINIO_BUCKET_STAGING")
+
+	info, err := uc.MinioUseCase.StatObject(ctx, staging, upload.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, fmt.Errorf("%w: object %s was not uploaded", errUploadMismatch, upload.ObjectKey)
+	}
+	if info.Size != upload.Size || info.Size > model.ImageUploadMaxFileSizeMB*1024*1024 {
+		return nil, fmt.Errorf("%w: object %s has size %d, expected %d", errUploadMismatch, upload.ObjectKey, info.Size, upload.Size)
+	}
+	if info.ContentType != upload.ContentType {
+		return nil, fmt.Errorf("%w: object %s has content type %s, expected %s", errUploadMismatch, upload.ObjectKey, info.ContentType, upload.ContentType)
+	}
+
+	object, err := uc.MinioUseCase.GetObject(ctx, staging, upload.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	content, err := io.ReadAll(io.LimitReader(object, upload.Size+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) != upload.Size {
+		return nil, fmt.Errorf("%w: object %s has size %d, expected %d", errUploadMismatch, upload.ObjectKey, len(content), upload.Size)
+	}
+	if detected := http.DetectContentType(content); detected != upload.ContentType {
+		return nil, fmt.Errorf("%w: object %s content is %s, expected %s", errUploadMismatch, upload.ObjectKey, detected, upload.ContentType)
+	}
+
+	checksum := sha256.Sum256(content)
+	if hex.EncodeToString(checksum[:]) != upload.ChecksumSHA256 {
+		return nil, fmt.Errorf("%w: object %s checksum mismatch", errUploadMismatch, upload.ObjectKey)
+	}
+
+	if err := uc.scanUpload(ctx, upload, content); err != nil {
+		return nil, err
+	}
+
+	sanitized, err := utils.SanitizeImage(content, productImageConstraints)
+	if err != nil {
+		return nil, fmt.Errorf("%w: object %s failed image validation: %v", errUploadMismatch, upload.ObjectKey, err)
+	}
+
+	return sanitized, nil
+}
+
+func (uc *ImageUseCase) scanUpload(ctx context.Context, upload *entity.ProductImageUpload, content []byte) error {
+	if uc.FileScanner == nil {
+		return nil
+	}
+
+	result, err := uc.FileScanner.Scan(ctx, bytes.NewReader(content))
+	if err != nil {
+		return fmt.Errorf("%w: %v", errUploadScanFailure, err)
+	}
+	if result.Clean {
+		return nil
+	}
+
+	if quarantine := uc.Viper.GetString("MINIO_BUCKET_QUARANTINE"); quarantine != "" {
+		bucket := uc.Viper.GetString("MINIO_BUCKET_PRODUCT")
+		if err := uc.MinioUseCase.Upload(ctx, model.UploadFileInput{
+			Bucket:      quarantine,
+			ObjectKey:   path.Join(bucket, upload.ObjectKey),
+			Content:     content,
+			ContentType: "application/octet-stream",
+			Metadata: map[string]string{
+				"original_name": upload.OriginalName,
+				"author_id":     upload.CreatedBy.String(),
+				"signature":     result.Signature,
+				"target_bucket": bucket,
+				"created_at":    time.Now().Format(time.RFC3339Nano),
+			},
+		}); err != nil {
+			uc.Log.WithError(err).WithFields(logrus.Fields{
+				"signature":  result.Signature,
+				"object_key": upload.ObjectKey,
+				"bucket":     quarantine,
+			}).Error("Failed to quarantine infected upload")
+			return fmt.Errorf("quarantine upload %s: %w", upload.ObjectKey, err)
+		}
+	}
+
+	return fmt.Errorf("%w: object %s matched %s", errUploadInfected, upload.ObjectKey, result.Signature)
+}
+
+func (uc *ImageUseCase) rejectUploads(ctx context.Context, uploads []entity.ProductImageUpload) {
+	bucket := uc.Viper.GetString("MINIO_BUCKET_STAGING")
+	for i := range uploads {
+		if err := uc.MinioUseCase.Delete(ctx, bucket, uploads[i].ObjectKey); err != nil {
+			uc.Log.WithError(err).Warnf("Failed to delete rejected upload %s", uploads[i].ObjectKey)
+		}
+
+		uploads[i].Status = model.ImageUploadStatusRejected
+		if err := uc.UploadRepository.Update(uc.DB.WithContext(ctx).Omit("Product"), &uploads[i]); err != nil {
+			uc.Log.WithError(err).Warnf("Failed to mark upload %s as rejected", uploads[i].ID)
+		}
+	}
+}
+
+func (uc *ImageUseCase) CollectOrphanedImages(ctx context.Context, olderThan time.Time) (int, error) {
+	db := uc.DB.WithContext(ctx)
+
+	imageKeys, err := uc.ImageRepository.FindAllObjectKeys(db)
+	if err != nil {
+		return 0, err
+	}
+
+	pendingKeys, err := uc.UploadRepository.FindPendingObjectKeys(db, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	live := make(map[string]bool, len(imageKeys))
+	stems := make(map[string]bool, len(imageKeys))
+	for _, key := range imageKeys {
+		live[key] = true
+		stems[strings.TrimSuffix(key, path.Ext(key))] = true
+	}
+
+	pending := make(map[string]bool, len(pendingKeys))
+	for _, key := range pendingKeys {
+		pending[key] = true
+	}
+
+	removed, err := uc.sweepBucket(ctx, uc.Viper.GetString("MINIO_BUCKET_PRODUCT"), olderThan, func(key string) bool {
+		if live[key] {
+			return true
+		}
+		if strings.HasPrefix(key, model.ImageRenditionPrefix) {
+			stem, ok := renditionSourceStem(key)
+			return ok && stems[stem]
+		}
+		return false
+	})
+	if err != nil {
+		return removed, err
+	}
+
+	staged, err := uc.sweepBucket(ctx, uc.Viper.GetString("MINIO_BUCKET_STAGING"), olderThan, func(key string) bool {
+		return pending[key]
+	})
+	return removed + staged, err
+}
+
+func (uc *ImageUseCase) sweepBucket(ctx context.Context, bucket string, olderThan time.Time, keep func(key string) bool) (int, error) {
+	objects, err := uc.MinioUseCase.ListObjects(ctx, bucket, "")
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, object := range objects {
+		if object.LastModified.After(olderThan) || keep(object.Key) {
+			continue
+		}
+
+		if err := uc.MinioUseCase.Delete(ctx, bucket, object.Key); err != nil {
+			uc.Log.WithError(err).Warnf("Failed to delete orphaned object %s", object.Key)
+			continue
+		}
+		removed++
+	}
+
+	return removed, nil
+}
+
+func (uc *ImageUseCase) GetDuplicateImages(ctx context.Context, limit, offset int, locale string) ([]*model.ImageDuplicateGroup, int64, error) {
+	db := uc.DB.WithContext(ctx)
+
+	total, err := uc.ImageRepository.CountDuplicateGroups(db)
+	if err != nil {
+		return nil, 0, utils.WrapMessageAsError(constants.FailedGetDuplicateImages, err)
+	}
+
+	groupIDs, err := uc.ImageRepository.FindDuplicateGroupIds(db, limit, offset)
+	if err != nil {
+		return nil, 0, utils.WrapMessageAsError(constants.FailedGetDuplicateImages, err)
+	}
+	if len(groupIDs) == 0 {
+		return []*model.ImageDuplicateGroup{}, total, nil
+	}
+
+	images, err := uc.ImageRepository.FindByDuplicateGroupIds(db, groupIDs)
+	if err != nil {
+		return nil, 0, utils.WrapMessageAsError(constants.FailedGetDuplicateImages, err)
+	}
+
+	members := make(map[uuid.UUID][]entity.ProductImage, len(groupIDs))
+	for _, image := range images {
+		members[*image.DuplicateGroupID] = append(members[*image.DuplicateGroupID], image)
+	}
+
+	groups := make([]*model.ImageDuplicateGroup, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		group := members[groupID]
+
+		maxDistance := 0
+		for i := range group {
+			for j := i + 1; j < len(group); j++ {
+				maxDistance = max(maxDistance, utils.HammingDistance(*group[i].PerceptualHash, *group[j].PerceptualHash))
+			}
+		}
+
+		responses := converter.ToProductImageResponses(group, locale)
+		uc.signImageURLs(ctx, responses)
+		groups = append(groups, &model.ImageDuplicateGroup{
+			ID:          groupID,
+			MaxDistance: maxDistance,
+			Images:      responses,
+		})
+	}
+
+	return groups, total, nil
+}
+
+// GroupDuplicateImages assigns duplicate groups to hashed images that were
+// stored before groups were recorded at creation time.
+func (uc *ImageUseCase) GroupDuplicateImages(ctx context.Context) (int, error) {
+	db := uc.DB.WithContext(ctx)
+
+	grouped := 0
+	var lastID uuid.UUID
+	for {
+		images, err := uc.ImageRepository.FindUngrouped(db, lastID, 500)
+		if err != nil {
+			return grouped, err
+		}
+		if len(images) == 0 {
+			return grouped, nil
+		}
+
+		for i := range images {
+			similar, err := uc.ImageRepository.FindSimilar(db, *images[i].PerceptualHash, model.ImageNearDuplicateDistance)
+			if err != nil {
+				return grouped, err
+			}
+			assigned, err := assignDuplicateGroup(db, uc.ImageRepository, &images[i], similar)
+			if err != nil {
+				return grouped, err
+			}
+			if assigned {
+				grouped++
+			}
+		}
+		lastID = images[len(images)-1].ID
+	}
+}
+
+func (uc *ImageUseCase) ApplyImageURLs(ctx context.Context, products ...*model.ProductResponse) {
+	for _, product := range products {
+		uc.signImageURLs(ctx, product.Images)
+	}
+}
+
+func (uc *ImageUseCase) ApplyDocumentImageURLs(ctx context.Context, documents []map[string]any) {
+	for _, document := range documents {
+		images, _ := document["images"].([]any)
+		for _, item := range images {
+			image, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			object, _ := image["image_object"].(string)
+			if object == "" {
+				continue
+			}
+
+			url, err := uc.presignImage(ctx, object)
+			if err != nil {
+				uc.Log.WithError(err).Warnf("Failed to get presigned URL for image %s", object)
+				continue
+			}
+			image["url"] = url
+		}
+	}
+}
+
+func (uc *ImageUseCase) signImageURLs(ctx context.Context, images []*model.ProductImageResponse) {
+	for _, image := range images {
+		url, err := uc.presignImage(ctx, image.ImageObject)
+		if err != nil {
+			uc.Log.WithError(err).Warnf("Failed to get presigned URL for image %s", image.ID)
+			continue
+		}
+		image.URL = url
+	}
+}
+
+func (uc *ImageUseCase) presignImage(ctx context.Context, objectKey string) (string, error) {
+	return uc.MinioUseCase.GetPresignedURL(ctx, model.PresignedURLInput{
+		Bucket:    uc.Viper.GetString("MINIO_BUCKET_PRODUCT"),
+		ObjectKey: objectKey,
+		Expiry:    int64((time.Hour * 24).Seconds()),
+	})
+}
+
+func (uc *ImageUseCase) reindexProduct(ctx context.Context, productID uuid.UUID) {
+	product, err := uc.ProductRepository.FindProductById(uc.DB.WithContext(ctx), productID)
+	if err != nil || product == nil {
+		uc.Log.WithError(err).Warnf("Failed to load product %s for reindex", productID)
+		return
+	}
+
+	if err := uc.ElasticsearchUseCase.InsertDocument(product.ID, product); err != nil {
+		uc.Log.WithError(err).Warnf("Failed to reindex product %s", productID)
+	}
+}
+
+var productImageConstraints = utils.ImageConstraints{
+	MinWidth:       model.ProductImageMinDimension,
+	MinHeight:      model.ProductImageMinDimension,
+	MaxWidth:       model.ProductImageMaxDimension,
+	MaxHeight:      model.ProductImageMaxDimension,
+	MinAspectRatio: model.ProductImageMinAspectRatio,
+	MaxAspectRatio: model.ProductImageMaxAspectRatio,
+}
+
+func createProductImages(db *gorm.DB, productRepository *repository.ProductRepository, imageRepository *repository.ImageRepository, product *entity.Product, uploads []model.UploadedImage) ([]entity.ProductImage, []*model.ImageDuplicateWarning, error) {
+	position := 0
+	if len(product.Images) > 0 {
+		position = product.Images[len(product.Images)-1].Position + 1
+	}
+	hasPrimary := slices.ContainsFunc(product.Images, func(image entity.ProductImage) bool { return image.IsPrimary })
+
+	contentHashes := make(map[string]bool, len(product.Images)+len(uploads))
+	for _, image := range product.Images {
+		if image.ContentHash != "" {
+			contentHashes[image.ContentHash] = true
+		}
+	}
+
+	images := make([]entity.ProductImage, 0, len(uploads))
+	var warnings []*model.ImageDuplicateWarning
+	for _, upload := range uploads {
+		if upload.ContentHash != "" {
+			if contentHashes[upload.ContentHash] {
+				return nil, nil, utils.WrapMessageAsError(constants.DuplicateProductImage)
+			}
+			contentHashes[upload.ContentHash] = true
+		}
+
+		image := entity.ProductImage{
+			ID:          uuid.New(),
+			ProductID:   product.ID,
+			ImageObject: upload.ObjectKey,
+			Width:       upload.Width,
+			Height:      upload.Height,
+			ContentHash: upload.ContentHash,
+			Position:    position,
+			IsPrimary:   !hasPrimary,
+		}
+		position++
+		hasPrimary = true
+
+		if upload.PerceptualHash != nil {
+			setPerceptualHash(&image, *upload.PerceptualHash)
+
+			similar, err := imageRepository.FindSimilar(db, *upload.PerceptualHash, model.ImageNearDuplicateDistance)
+			if err != nil {
+				return nil, nil, err
+			}
+			if _, err := assignDuplicateGroup(db, imageRepository, &image, similar); err != nil {
+				return nil, nil, err
+			}
+			for _, match := range similar {
+				warnings = append(warnings, &model.ImageDuplicateWarning{
+					ImageID:            image.ID,
+					ObjectKey:          image.ImageObject,
+					DuplicateImageID:   match.ID,
+					DuplicateProductID: match.ProductID,
+					Distance:           utils.HammingDistance(*upload.PerceptualHash, *match.PerceptualHash),
+				})
+			}
+		}
+
+		if err := productRepository.CreateImage(db.Omit("Product"), &image); err != nil {
+			return nil, nil, err
+		}
+		images = append(images, image)
+	}
+
+	return images, warnings, nil
+}
+
+func setPerceptualHash(image *entity.ProductImage, hash uint64) {
+	bands := utils.PerceptualHashBands(hash)
+	image.PerceptualHash = &hash
+	image.HashBand0, image.HashBand1, image.HashBand2, image.HashBand3 = &bands[0], &bands[1], &bands[2], &bands[3]
+	image.HashBand4, image.HashBand5, image.HashBand6, image.HashBand7 = &bands[4], &bands[5], &bands[6], &bands[7]
+}
+
+// assignDuplicateGroup puts image in the same duplicate group as its near
+// matches, merging their groups when the image links two of them. The image
+// itself may be among the matches when it is already stored.
+func assignDuplicateGroup(db *gorm.DB, imageRepository *repository.ImageRepository, image *entity.ProductImage, matches []entity.ProductImage) (bool, error) {
+	groupID := image.DuplicateGroupID
+	var imageIDs, groupIDs []uuid.UUID
+	others := 0
+	for _, match := range matches {
+		if match.ID != image.ID {
+			others++
+		}
+		switch {
+		case match.DuplicateGroupID == nil:
+			imageIDs = append(imageIDs, match.ID)
+		case groupID == nil:
+			groupID = match.DuplicateGroupID
+		case *match.DuplicateGroupID != *groupID:
+			groupIDs = append(groupIDs, *match.DuplicateGroupID)
+		}
+	}
+	if others == 0 {
+		return false, nil
+	}
+
+	if groupID == nil {
+		id := uuid.New()
+		groupID = &id
+	}
+	image.DuplicateGroupID = groupID
+
+	if len(imageIDs) == 0 && len(groupIDs) == 0 {
+		return true, nil
+	}
+	return true, imageRepository.MergeDuplicateGroups(db, *groupID, imageIDs, groupIDs)
+}
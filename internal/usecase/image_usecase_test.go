@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/repository"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// objectStore is a minimal path-style S3 server holding objects in memory,
+// enough for the stat, get, put and delete calls made by MinioRepository.
+type objectStore struct {
+	mu      sync.Mutex
+	objects map[string]storedObject
+}
+
+type storedObject struct {
+	content     []byte
+	contentType string
+}
+
+func (s *objectStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodPut:
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.objects[name] = storedObject{content: content, contentType: r.Header.Get("Content-Type")}
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodDelete:
+		delete(s.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodHead, http.MethodGet:
+		object, ok := s.objects[name]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				io.WriteString(w, `<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>`)
+			}
+			return
+		}
+		w.Header().Set("Content-Type", object.contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.content)))
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		if r.Method == http.MethodGet {
+			w.Write(object.content)
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *objectStore) keys(bucket string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var keys []string
+	for name := range s.objects {
+		if strings.HasPrefix(name, bucket+"/") {
+			keys = append(keys, strings.TrimPrefix(name, bucket+"/"))
+		}
+	}
+	return keys
+}
+
+func newTestImageUseCase(t *testing.T) (*ImageUseCase, sqlmock.Sqlmock, *objectStore) {
+	t.Helper()
+
+	store := &objectStore{objects: map[string]storedObject{}}
+	server := httptest.NewServer(store)
+	t.Cleanup(server.Close)
+
+	client, err := minio.New(strings.TrimPrefix(server.URL, "http://"), &minio.Options{
+		Creds:  credentials.NewStaticV4("access", "secret", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("minio.New() error = %v", err)
+	}
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+
+	config := viper.New()
+	config.Set("MINIO_BUCKET_PRODUCT", "products")
+	config.Set("MINIO_BUCKET_STAGING", "staging")
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	validate := validator.New()
+	minioUseCase := NewMinioUsecase(repository.NewMinioRepository(client), validate, log)
+
+	uc := NewImageUsecase(db, log, validate, config, repository.NewImageRepository(log), repository.NewProductRepository(log), repository.NewImageUploadRepository(log), minioUseCase, nil, nil)
+	return uc, mock, store
+}
+
+func stageUpload(t *testing.T, store *objectStore, productID uuid.UUID, content []byte) entity.ProductImageUpload {
+	t.Helper()
+
+	checksum := sha256.Sum256(content)
+	upload := entity.ProductImageUpload{
+		ID:             uuid.New(),
+		ProductID:      productID,
+		ObjectKey:      "products/" + uuid.NewString() + ".png",
+		OriginalName:   "photo.png",
+		ContentType:    "image/png",
+		Size:           int64(len(content)),
+		ChecksumSHA256: hex.EncodeToString(checksum[:]),
+		Status:         model.ImageUploadStatusPending,
+		ExpiresAt:      time.Now().Add(time.Hour),
+		CreatedBy:      uuid.New(),
+	}
+	store.objects["staging/"+upload.ObjectKey] = storedObject{content: content, contentType: upload.ContentType}
+	return upload
+}
+
+func uploadRows(uploads ...entity.ProductImageUpload) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "product_id", "object_key", "original_name", "content_type", "size", "checksum_sha256", "status", "expires_at", "created_by"})
+	for _, upload := range uploads {
+		rows.AddRow(upload.ID.String(), upload.ProductID.String(), upload.ObjectKey, upload.OriginalName, upload.ContentType, upload.Size, upload.ChecksumSHA256, upload.Status, upload.ExpiresAt, upload.CreatedBy.String())
+	}
+	return rows
+}
+
+func pngImage(t *testing.T, size int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := range size {
+		for x := range size {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: uint8(x ^ y), A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestConfirmUploadsLeavesNothingPublishedOnFailure(t *testing.T) {
+	productID := uuid.New()
+	content := pngImage(t, model.ProductImageMinDimension)
+
+	t.Run("product deleted before confirmation", func(t *testing.T) {
+		uc, mock, store := newTestImageUseCase(t)
+		upload := stageUpload(t, store, productID, content)
+
+		mock.ExpectQuery("SELECT \\* FROM `product_image_uploads`").WillReturnRows(uploadRows(upload))
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT \\* FROM `products`").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		_, err := uc.ConfirmUploads(context.Background(), productID, &model.ImageUploadConfirmRequest{UploadIDs: []uuid.UUID{upload.ID}}, "en")
+		if err == nil {
+			t.Fatal("ConfirmUploads() error = nil, want product not found")
+		}
+		if keys := store.keys("products"); len(keys) != 0 {
+			t.Fatalf("product bucket holds %v after a failed confirmation", keys)
+		}
+		if keys := store.keys("staging"); len(keys) != 1 {
+			t.Fatalf("staging bucket holds %v, want the upload kept for a retry", keys)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("upload confirmed concurrently", func(t *testing.T) {
+		uc, mock, store := newTestImageUseCase(t)
+		first := stageUpload(t, store, productID, content)
+		second := stageUpload(t, store, productID, content)
+
+		mock.ExpectQuery("SELECT \\* FROM `product_image_uploads`").WillReturnRows(uploadRows(first, second))
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT \\* FROM `products`").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(productID.String()))
+		mock.ExpectQuery("SELECT \\* FROM `product_categories`").WillReturnRows(sqlmock.NewRows([]string{"product_id", "category_id"}))
+		mock.ExpectQuery("SELECT \\* FROM `product_images`").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectExec("UPDATE `product_image_uploads`").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectRollback()
+
+		_, err := uc.ConfirmUploads(context.Background(), productID, &model.ImageUploadConfirmRequest{UploadIDs: []uuid.UUID{first.ID, second.ID}}, "en")
+		if err == nil {
+			t.Fatal("ConfirmUploads() error = nil, want upload not pending")
+
+		}
+		if keys := store.keys("products"); len(keys) != 0 {
+			t.Fatalf("product bucket holds %v after a failed confirmation", keys)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("another upload in the batch rejected", func(t *testing.T) {
+		uc, mock, store := newTestImageUseCase(t)
+		valid := stageUpload(t, store, productID, content)
+		tampered := stageUpload(t, store, productID, content)
+		store.objects["staging/"+tampered.ObjectKey] = storedObject{content: pngImage(t, model.ProductImageMinDimension+1), contentType: tampered.ContentType}
+
+		mock.ExpectQuery("SELECT \\* FROM `product_image_uploads`").WillReturnRows(uploadRows(valid, tampered))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `product_image_uploads`").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		_, err := uc.ConfirmUploads(context.Background(), productID, &model.ImageUploadConfirmRequest{UploadIDs: []uuid.UUID{valid.ID, tampered.ID}}, "en")
+		if err == nil {
+			t.Fatal("ConfirmUploads() error = nil, want verification failure")
+		}
+		if keys := store.keys("products"); len(keys) != 0 {
+			t.Fatalf("product bucket holds %v after a rejected batch", keys)
+		}
+		if keys := store.keys("staging"); len(keys) != 1 || keys[0] != valid.ObjectKey {
+			t.Fatalf("staging bucket holds %v, want only %s", keys, valid.ObjectKey)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -42,6 +42,10 @@ func (u *MinioUseCase) GetPresignedURL(ctx context.Context, input model.Presigne
 	return u.Repo.GeneratePresignedURL(ctx, input)
 }
 
+func (u *MinioUseCase) GetPresignedPutURL(ctx context.Context, input model.PresignedURLInput) (string, error) {
+	return u.Repo.GeneratePresignedPutURL(ctx, input)
+}
+
 func (u *MinioUseCase) Delete(ctx context.Context, bucket, objectKey string) error {
 	return u.Repo.DeleteFile(ctx, bucket, objectKey)
 }
@@ -49,3 +53,11 @@ func (u *MinioUseCase) Delete(ctx context.Context, bucket, objectKey string) err
 func (u *MinioUseCase) GetObject(ctx context.Context, bucket, objectKey string) (*minio.Object, error) {
 	return u.Repo.GetObject(ctx, bucket, objectKey)
 }
+
+func (u *MinioUseCase) StatObject(ctx context.Context, bucket, objectKey string) (*minio.ObjectInfo, error) {
+	return u.Repo.StatObject(ctx, bucket, objectKey)
+}
+
+func (u *MinioUseCase) ListObjects(ctx context.Context, bucket, prefix string) ([]minio.ObjectInfo, error) {
+	return u.Repo.ListObjects(ctx, bucket, prefix)
+}
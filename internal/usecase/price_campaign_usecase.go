@@ -0,0 +1,442 @@
+package usecase
+
+import (
+	"context"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type PriceCampaignUseCase struct {
+	DB                      *gorm.DB
+	Log                     *logrus.Logger
+	Validate                *validator.Validate
+	PriceCampaignRepository *repository.PriceCampaignRepository
+	ProductRepository       *repository.ProductRepository
+	CategoryRepository      *repository.CategoryRepository
+	BrandRepository         *repository.BrandRepository
+	ElasticsearchUseCase    *ElasticsearchUseCase
+}
+
+func NewPriceCampaignUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, priceCampaignRepository *repository.PriceCampaignRepository, productRepository *repository.ProductRepository, categoryRepository *repository.CategoryRepository, brandRepository *repository.BrandRepository, elasticsearchUseCase *ElasticsearchUseCase) *PriceCampaignUseCase {
+	return &PriceCampaignUseCase{
+		DB:                      db,
+		Log:                     log,
+		Validate:                validate,
+		PriceCampaignRepository: priceCampaignRepository,
+		ProductRepository:       productRepository,
+		CategoryRepository:      categoryRepository,
+		BrandRepository:         brandRepository,
+		ElasticsearchUseCase:    elasticsearchUseCase,
+	}
+}
+
+func (uc *PriceCampaignUseCase) GetPriceCampaigns(ctx context.Context) ([]*model.PriceCampaignResponse, error) {
+	campaigns, err := uc.PriceCampaignRepository.GetAll(uc.DB.WithContext(ctx))
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetPriceCampaigns, err)
+	}
+
+	responses := make([]*model.PriceCampaignResponse, 0, len(campaigns))
+	for i := range campaigns {
+		responses = append(responses, converter.ToPriceCampaignResponse(&campaigns[i]))
+	}
+
+	return responses, nil
+}
+
+func (uc *PriceCampaignUseCase) GetPriceCampaignByID(ctx context.Context, campaignID uuid.UUID) (*model.PriceCampaignResponse, error) {
+	campaign, err := uc.PriceCampaignRepository.FindPriceCampaignById(uc.DB.WithContext(ctx), campaignID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find price campaign by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetPriceCampaignByID, err)
+	}
+
+	if campaign == nil {
+		return nil, utils.WrapMessageAsError(constants.PriceCampaignNotFound)
+	}
+
+	return converter.ToPriceCampaignResponse(campaign), nil
+}
+
+func (uc *PriceCampaignUseCase) CreatePriceCampaign(ctx context.Context, request *model.PriceCampaignRequest, actorID uuid.UUID) (*model.PriceCampaignResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	campaign := &entity.PriceCampaign{ID: uuid.New(), CreatedBy: actorID}
+	if err := uc.applyPriceCampaignRequest(tx, campaign, request); err != nil {
+		return nil, err
+	}
+
+	if err := uc.PriceCampaignRepository.Create(tx, campaign); err != nil {
+		uc.Log.WithError(err).Error("Failed to create price campaign")
+		return nil, utils.WrapMessageAsError(constants.FailedCreatePriceCampaign, err)
+	}
+
+	products, err := uc.refreshCampaignProducts(tx, campaign)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to refresh effective prices")
+		return nil, utils.WrapMessageAsError(constants.FailedCreatePriceCampaign, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for price campaign creation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreatePriceCampaign, err)
+	}
+
+	uc.reindexProducts(products)
+
+	return converter.ToPriceCampaignResponse(campaign), nil
+}
+
+func (uc *PriceCampaignUseCase) UpdatePriceCampaign(ctx context.Context, campaignID uuid.UUID, request *model.PriceCampaignRequest) (*model.PriceCampaignResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	campaign, err := uc.PriceCampaignRepository.FindPriceCampaignById(tx, campaignID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find price campaign by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetPriceCampaignByID, err)
+	}
+
+	if campaign == nil {
+		return nil, utils.WrapMessageAsError(constants.PriceCampaignNotFound)
+	}
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	previous := *campaign
+	if err := uc.applyPriceCampaignRequest(tx, campaign, request); err != nil {
+		return nil, err
+	}
+
+	if err := uc.PriceCampaignRepository.Update(tx, campaign); err != nil {
+		uc.Log.WithError(err).Error("Failed to update price campaign")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdatePriceCampaign, err)
+	}
+
+	products, err := uc.refreshCampaignProducts(tx, &previous, campaign)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to refresh effective prices")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdatePriceCampaign, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for price campaign update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdatePriceCampaign, err)
+	}
+
+	uc.reindexProducts(products)
+
+	return converter.ToPriceCampaignResponse(campaign), nil
+}
+
+func (uc *PriceCampaignUseCase) DeletePriceCampaign(ctx context.Context, campaignID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	campaign, err := uc.PriceCampaignRepository.FindPriceCampaignById(tx, campaignID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find price campaign by ID")
+		return utils.WrapMessageAsError(constants.FailedGetPriceCampaignByID, err)
+	}
+
+	if campaign == nil {
+		return utils.WrapMessageAsError(constants.PriceCampaignNotFound)
+	}
+
+	if err := uc.PriceCampaignRepository.Delete(tx, campaign); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete price campaign")
+		return utils.WrapMessageAsError(constants.FailedDeletePriceCampaign, err)
+	}
+
+	products, err := uc.refreshCampaignProducts(tx, campaign)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to refresh effective prices")
+		return utils.WrapMessageAsError(constants.FailedDeletePriceCampaign, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for price campaign deletion")
+		return utils.WrapMessageAsError(constants.FailedDeletePriceCampaign, err)
+	}
+
+	uc.reindexProducts(products)
+
+	return nil
+}
+
+func (uc *PriceCampaignUseCase) RunCampaignTransitions(ctx context.Context, now time.Time) (int, error) {
+	campaigns, err := uc.PriceCampaignRepository.FindDueForTransition(uc.DB.WithContext(ctx), now)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for i := range campaigns {
+		if err := uc.applyCampaignTransition(ctx, &campaigns[i], now); err != nil {
+			uc.Log.WithError(err).Errorf("Failed to apply price campaign %s", campaigns[i].ID)
+			continue
+		}
+		applied++
+	}
+
+	return applied, nil
+}
+
+func (uc *PriceCampaignUseCase) applyCampaignTransition(ctx context.Context, campaign *entity.PriceCampaign, now time.Time) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	campaign.Applied = campaign.IsLive(now)
+	if err := tx.Model(campaign).UpdateColumn("applied", campaign.Applied).Error; err != nil {
+		return err
+	}
+
+	products, err := uc.refreshCampaignProducts(tx, campaign)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	uc.reindexProducts(products)
+
+	return nil
+}
+
+func (uc *PriceCampaignUseCase) applyPriceCampaignRequest(tx *gorm.DB, campaign *entity.PriceCampaign, request *model.PriceCampaignRequest) error {
+	if request.DiscountType == model.DiscountTypePercentage && request.Value > model.PercentageDiscountMax {
+		return utils.WrapMessageAsError(constants.InvalidPercentageDiscount)
+	}
+
+	exists, err := uc.targetExists(tx, request.Scope, request.TargetID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find price campaign target")
+		return utils.WrapMessageAsError(constants.FailedCreatePriceCampaign, err)
+	}
+
+	if !exists {
+		return utils.WrapMessageAsError(constants.PriceCampaignTargetNotFound)
+	}
+
+	campaign.Name = strings.TrimSpace(request.Name)
+	campaign.Scope = request.Scope
+	campaign.TargetID = request.TargetID
+	campaign.DiscountType = request.DiscountType
+	campaign.Value = request.Value
+	campaign.Priority = request.Priority
+	campaign.StartsAt = request.StartsAt
+	campaign.EndsAt = request.EndsAt
+	campaign.Active = request.Active == nil || *request.Active
+	campaign.Applied = campaign.IsLive(time.Now())
+
+	return nil
+}
+
+func (uc *PriceCampaignUseCase) targetExists(tx *gorm.DB, scope string, targetID uuid.UUID) (bool, error) {
+	switch scope {
+	case model.PriceCampaignScopeProduct:
+		total, err := uc.ProductRepository.CountById(tx, targetID)
+		return total > 0, err
+	case model.PriceCampaignScopeCategory:
+		category, err := uc.CategoryRepository.FindCategoryById(tx, targetID)
+		return category != nil, err
+	case model.PriceCampaignScopeBrand:
+		brand, err := uc.BrandRepository.FindBrandById(tx, targetID)
+		return brand != nil, err
+	}
+	return false, nil
+}
+
+func (uc *PriceCampaignUseCase) refreshCampaignProducts(tx *gorm.DB, campaigns ...*entity.PriceCampaign) ([]*entity.Product, error) {
+	var productIDs []uuid.UUID
+	for _, campaign := range campaigns {
+		ids, err := uc.campaignProductIds(tx, campaign)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			if !slices.Contains(productIDs, id) {
+				productIDs = append(productIDs, id)
+			}
+		}
+	}
+
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	products, err := uc.ProductRepository.FindProductsByIds(tx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]*entity.Product, 0, len(products))
+	for i := range products {
+		targets = append(targets, &products[i])
+	}
+
+	return refreshEffectivePrices(tx, uc.CategoryRepository, uc.PriceCampaignRepository, targets, time.Now())
+}
+
+func (uc *PriceCampaignUseCase) campaignProductIds(tx *gorm.DB, campaign *entity.PriceCampaign) ([]uuid.UUID, error) {
+	switch campaign.Scope {
+	case model.PriceCampaignScopeProduct:
+		return []uuid.UUID{campaign.TargetID}, nil
+
+	case model.PriceCampaignScopeBrand:
+		var productIDs []uuid.UUID
+		err := tx.Model(&entity.Product{}).Where("brand_id = ?", campaign.TargetID).Pluck("id", &productIDs).Error
+		return productIDs, err
+
+	case model.PriceCampaignScopeCategory:
+		categories, err := uc.CategoryRepository.GetAll(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		_, nodes := buildCategoryTree(categories)
+		node, ok := nodes[campaign.TargetID]
+		if !ok {
+			return nil, nil
+		}
+
+		return uc.CategoryRepository.FindProductIdsByCategoryIds(tx, collectCategorySubtree(node))
+	}
+	return nil, nil
+}
+
+func (uc *PriceCampaignUseCase) reindexProducts(products []*entity.Product) {
+	for _, product := range products {
+		if err := uc.ElasticsearchUseCase.InsertDocument(product.ID, product); err != nil {
+			uc.Log.WithError(err).Warnf("Failed to reindex product %s after price change", product.ID)
+		}
+	}
+}
+
+func resolveEffectivePrices(db *gorm.DB, categoryRepository *repository.CategoryRepository, priceCampaignRepository *repository.PriceCampaignRepository, products []*entity.Product, now time.Time) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	categories, err := categoryRepository.GetAll(db)
+	if err != nil {
+		return err
+	}
+
+	parents := make(map[uuid.UUID]*uuid.UUID, len(categories))
+	for _, category := range categories {
+		parents[category.ID] = category.ParentID
+	}
+
+	productIDs := make([]uuid.UUID, 0, len(products))
+	var categoryIDs, brandIDs []uuid.UUID
+	chains := make(map[uuid.UUID][]uuid.UUID, len(products))
+	for _, product := range products {
+		productIDs = append(productIDs, product.ID)
+		if product.BrandID != nil && !slices.Contains(brandIDs, *product.BrandID) {
+			brandIDs = append(brandIDs, *product.BrandID)
+		}
+
+		for _, category := range product.Categories {
+			for current := &category.ID; current != nil && !slices.Contains(chains[product.ID], *current); current = parents[*current] {
+				chains[product.ID] = append(chains[product.ID], *current)
+				if !slices.Contains(categoryIDs, *current) {
+					categoryIDs = append(categoryIDs, *current)
+				}
+			}
+		}
+	}
+
+	campaigns, err := priceCampaignRepository.FindLiveForTargets(db, now, productIDs, categoryIDs, brandIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		var applicable []entity.PriceCampaign
+		for _, campaign := range campaigns {
+			switch campaign.Scope {
+			case model.PriceCampaignScopeProduct:
+				if campaign.TargetID != product.ID {
+					continue
+				}
+			case model.PriceCampaignScopeCategory:
+				if !slices.Contains(chains[product.ID], campaign.TargetID) {
+					continue
+				}
+			case model.PriceCampaignScopeBrand:
+				if product.BrandID == nil || campaign.TargetID != *product.BrandID {
+					continue
+				}
+			}
+			applicable = append(applicable, campaign)
+		}
+
+		price, winner := utils.ResolveEffectivePrice(product.Price, applicable)
+		product.EffectivePrice = price
+		product.PriceCampaignID = nil
+		if winner != nil {
+			product.PriceCampaignID = &winner.ID
+		}
+	}
+
+	return nil
+}
+
+func refreshEffectivePrices(db *gorm.DB, categoryRepository *repository.CategoryRepository, priceCampaignRepository *repository.PriceCampaignRepository, products []*entity.Product, now time.Time) ([]*entity.Product, error) {
+	type pricing struct {
+		price      int64
+		campaignID *uuid.UUID
+	}
+
+	previous := make([]pricing, len(products))
+	for i, product := range products {
+		previous[i] = pricing{product.EffectivePrice, product.PriceCampaignID}
+	}
+
+	if err := resolveEffectivePrices(db, categoryRepository, priceCampaignRepository, products, now); err != nil {
+		return nil, err
+	}
+
+	var changed []*entity.Product
+	for i, product := range products {
+		sameCampaign := (previous[i].campaignID == nil && product.PriceCampaignID == nil) ||
+			(previous[i].campaignID != nil && product.PriceCampaignID != nil && *previous[i].campaignID == *product.PriceCampaignID)
+		if previous[i].price == product.EffectivePrice && sameCampaign {
+			continue
+		}
+
+		if err := db.Model(product).UpdateColumns(map[string]any{
+			"effective_price":   product.EffectivePrice,
+			"price_campaign_id": product.PriceCampaignID,
+		}).Error; err != nil {
+			return nil, err
+		}
+		changed = append(changed, product)
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,310 @@
+package usecase
+
+import (
+	"context"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type PriceListUseCase struct {
+	DB                  *gorm.DB
+	Log                 *logrus.Logger
+	Validate            *validator.Validate
+	PriceListRepository *repository.PriceListRepository
+	PriceTierRepository *repository.PriceTierRepository
+	ProductRepository   *repository.ProductRepository
+}
+
+func NewPriceListUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, priceListRepository *repository.PriceListRepository, priceTierRepository *repository.PriceTierRepository, productRepository *repository.ProductRepository) *PriceListUseCase {
+	return &PriceListUseCase{
+		DB:                  db,
+		Log:                 log,
+		Validate:            validate,
+		PriceListRepository: priceListRepository,
+		PriceTierRepository: priceTierRepository,
+		ProductRepository:   productRepository,
+	}
+}
+
+func (uc *PriceListUseCase) GetPriceLists(ctx context.Context) ([]*model.PriceListResponse, error) {
+	priceLists, err := uc.PriceListRepository.GetAll(uc.DB.WithContext(ctx))
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetPriceLists, err)
+	}
+
+	responses := make([]*model.PriceListResponse, 0, len(priceLists))
+	for i := range priceLists {
+		responses = append(responses, converter.ToPriceListResponse(&priceLists[i]))
+	}
+
+	return responses, nil
+}
+
+func (uc *PriceListUseCase) GetPriceListByID(ctx context.Context, priceListID uuid.UUID) (*model.PriceListResponse, error) {
+	priceList, err := uc.PriceListRepository.FindPriceListById(uc.DB.WithContext(ctx), priceListID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find price list by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetPriceListByID, err)
+	}
+
+	if priceList == nil {
+		return nil, utils.WrapMessageAsError(constants.PriceListNotFound)
+	}
+
+	return converter.ToPriceListResponse(priceList), nil
+}
+
+func (uc *PriceListUseCase) CreatePriceList(ctx context.Context, request *model.PriceListRequest, actorID uuid.UUID) (*model.PriceListResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	priceList := &entity.PriceList{ID: uuid.New(), Active: true, CreatedBy: actorID}
+	if err := uc.applyPriceListRequest(tx, priceList, request); err != nil {
+		return nil, err
+	}
+
+	if err := uc.PriceListRepository.Create(tx, priceList); err != nil {
+		uc.Log.WithError(err).Error("Failed to create price list")
+		return nil, utils.WrapMessageAsError(constants.FailedCreatePriceList, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for price list creation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreatePriceList, err)
+	}
+
+	return converter.ToPriceListResponse(priceList), nil
+}
+
+func (uc *PriceListUseCase) UpdatePriceList(ctx context.Context, priceListID uuid.UUID, request *model.PriceListRequest) (*model.PriceListResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	priceList, err := uc.PriceListRepository.FindPriceListById(tx, priceListID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find price list by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetPriceListByID, err)
+	}
+
+	if priceList == nil {
+		return nil, utils.WrapMessageAsError(constants.PriceListNotFound)
+	}
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	if err := uc.applyPriceListRequest(tx, priceList, request); err != nil {
+		return nil, err
+	}
+
+	if err := uc.PriceListRepository.Update(tx, priceList); err != nil {
+		uc.Log.WithError(err).Error("Failed to update price list")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdatePriceList, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for price list update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdatePriceList, err)
+	}
+
+	return converter.ToPriceListResponse(priceList), nil
+}
+
+func (uc *PriceListUseCase) DeletePriceList(ctx context.Context, priceListID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	priceList, err := uc.PriceListRepository.FindPriceListById(tx, priceListID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find price list by ID")
+		return utils.WrapMessageAsError(constants.FailedGetPriceListByID, err)
+	}
+
+	if priceList == nil {
+		return utils.WrapMessageAsError(constants.PriceListNotFound)
+	}
+
+	if err := tx.Where("price_list_id = ?", priceList.ID).Delete(&entity.PriceTier{}).Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to delete price list tiers")
+		return utils.WrapMessageAsError(constants.FailedDeletePriceList, err)
+	}
+
+	if err := uc.PriceListRepository.Delete(tx, priceList); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete price list")
+		return utils.WrapMessageAsError(constants.FailedDeletePriceList, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for price list deletion")
+		return utils.WrapMessageAsError(constants.FailedDeletePriceList, err)
+	}
+
+	return nil
+}
+
+func (uc *PriceListUseCase) GetProductPriceTiers(ctx context.Context, productID uuid.UUID) ([]*model.PriceTierResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	product, err := uc.ProductRepository.FindProductById(db, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetPriceTiers, err)
+	}
+
+	if product == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	tiers, err := uc.PriceTierRepository.FindByProductId(db, productID)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetPriceTiers, err)
+	}
+
+	return converter.ToPriceTierResponses(tiers), nil
+}
+
+func (uc *PriceListUseCase) UpdateProductPriceTiers(ctx context.Context, productID uuid.UUID, request *model.ProductPriceTiersRequest, actorID uuid.UUID) ([]*model.PriceTierResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	product, err := uc.ProductRepository.FindProductById(tx, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdatePriceTiers, err)
+	}
+
+	if product == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	if request.PriceListID != nil {
+		priceList, err := uc.PriceListRepository.FindPriceListById(tx, *request.PriceListID)
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to find price list by ID")
+			return nil, utils.WrapMessageAsError(constants.FailedUpdatePriceTiers, err)
+		}
+
+		if priceList == nil {
+			return nil, utils.WrapMessageAsError(constants.PriceListNotFound)
+		}
+	}
+
+	tiers := make([]entity.PriceTier, 0, len(request.Tiers))
+	for _, tier := range request.Tiers {
+		tiers = append(tiers, entity.PriceTier{
+			ID:          uuid.New(),
+			ProductID:   product.ID,
+			PriceListID: request.PriceListID,
+			MinQuantity: tier.MinQuantity,
+			UnitPrice:   tier.UnitPrice,
+			CreatedBy:   actorID,
+		})
+	}
+
+	if err := uc.PriceTierRepository.Replace(tx, product.ID, request.PriceListID, tiers); err != nil {
+		uc.Log.WithError(err).Error("Failed to replace price tiers")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdatePriceTiers, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for price tier update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdatePriceTiers, err)
+	}
+
+	return uc.GetProductPriceTiers(ctx, productID)
+}
+
+func (uc *PriceListUseCase) applyPriceListRequest(tx *gorm.DB, priceList *entity.PriceList, request *model.PriceListRequest) error {
+	customerGroup := strings.ToLower(strings.TrimSpace(request.CustomerGroup))
+
+	existing, err := uc.PriceListRepository.FindByCustomerGroup(tx, customerGroup)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find price list by customer group")
+		return utils.WrapMessageAsError(constants.FailedGetPriceListByID, err)
+	}
+
+	if existing != nil && existing.ID != priceList.ID {
+		return utils.WrapMessageAsError(constants.PriceListCustomerGroupTaken)
+	}
+
+	priceList.Name = request.Name
+	priceList.CustomerGroup = customerGroup
+	if request.Active != nil {
+		priceList.Active = *request.Active
+	}
+
+	return nil
+}
+
+func quoteProductPrice(db *gorm.DB, priceListRepository *repository.PriceListRepository, priceTierRepository *repository.PriceTierRepository, product *entity.Product, quantity int, customerGroup string) (*model.PriceQuoteResponse, error) {
+	quote := &model.PriceQuoteResponse{
+		ProductID:       product.ID,
+		Quantity:        quantity,
+		CustomerGroup:   customerGroup,
+		Currency:        converter.SourceCurrency(product.Currency),
+		ListPrice:       product.Price,
+		UnitPrice:       product.EffectivePrice,
+		Source:          model.PriceSourceBase,
+		PriceCampaignID: product.PriceCampaignID,
+	}
+	if product.PriceCampaignID != nil {
+		quote.Source = model.PriceSourceCampaign
+	}
+
+	var priceListID *uuid.UUID
+	if customerGroup != "" {
+		priceList, err := priceListRepository.FindByCustomerGroup(db, customerGroup)
+		if err != nil {
+			return nil, err
+		}
+		if priceList != nil && priceList.Active {
+			priceListID = &priceList.ID
+		}
+	}
+
+	tiers, err := priceTierRepository.FindApplicable(db, product.ID, quantity, priceListID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range tiers {
+		if tiers[i].UnitPrice >= quote.UnitPrice {
+			continue
+		}
+
+		quote.UnitPrice = tiers[i].UnitPrice
+		quote.PriceTierID = &tiers[i].ID
+		quote.PriceListID = tiers[i].PriceListID
+		quote.PriceCampaignID = nil
+		quote.Source = model.PriceSourceTier
+		if tiers[i].PriceListID != nil {
+			quote.Source = model.PriceSourcePriceList
+		}
+	}
+
+	quote.Total = quote.UnitPrice * int64(quantity)
+
+	return quote, nil
+}
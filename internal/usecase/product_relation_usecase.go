@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ProductRelationUseCase struct {
+	DB                        *gorm.DB
+	Log                       *logrus.Logger
+	Validate                  *validator.Validate
+	ProductRepository         *repository.ProductRepository
+	ProductRelationRepository *repository.ProductRelationRepository
+}
+
+func NewProductRelationUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, productRepository *repository.ProductRepository, productRelationRepository *repository.ProductRelationRepository) *ProductRelationUseCase {
+	return &ProductRelationUseCase{
+		DB:                        db,
+		Log:                       log,
+		Validate:                  validate,
+		ProductRepository:         productRepository,
+		ProductRelationRepository: productRelationRepository,
+	}
+}
+
+func (uc *ProductRelationUseCase) GetProductRelations(ctx context.Context, productID uuid.UUID) ([]*model.ProductRelationResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	product, err := uc.ProductRepository.FindProductById(db, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+
+	if product == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	relations, err := uc.ProductRelationRepository.FindByProductIds(db, []uuid.UUID{productID}, nil)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductRelations, err)
+	}
+
+	responses := make([]*model.ProductRelationResponse, 0, len(relations))
+	for i := range relations {
+		responses = append(responses, converter.ToProductRelationResponse(&relations[i]))
+	}
+
+	return responses, nil
+}
+
+func (uc *ProductRelationUseCase) CreateProductRelation(ctx context.Context, productID uuid.UUID, request *model.ProductRelationRequest, actorID uuid.UUID) (*model.ProductRelationResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	if request.RelatedProductID == productID {
+		return nil, utils.WrapMessageAsError(constants.ProductRelationSelf)
+	}
+
+	products, err := uc.ProductRepository.FindProductsByIds(tx, []uuid.UUID{productID, request.RelatedProductID})
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find products by IDs")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateProductRelation, err)
+	}
+
+	found := map[uuid.UUID]bool{}
+	for _, product := range products {
+		found[product.ID] = true
+	}
+
+	if !found[productID] {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	if !found[request.RelatedProductID] {
+		return nil, utils.WrapMessageAsError(constants.RelatedProductNotFound)
+	}
+
+	existing, err := uc.ProductRelationRepository.FindRelation(tx, productID, request.RelatedProductID, request.Type)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product relation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateProductRelation, err)
+	}
+
+	if existing != nil {
+		return nil, utils.WrapMessageAsError(constants.ProductRelationExists)
+	}
+
+	relation := &entity.ProductRelation{
+		ID:               uuid.New(),
+		ProductID:        productID,
+		RelatedProductID: request.RelatedProductID,
+		Type:             request.Type,
+		Position:         request.Position,
+		CreatedBy:        actorID,
+	}
+
+	if err := uc.ProductRelationRepository.Create(tx.Omit("Product", "RelatedProduct"), relation); err != nil {
+		uc.Log.WithError(err).Error("Failed to create product relation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateProductRelation, err)
+	}
+
+	if inverseType, ok := model.ProductRelationInverses[request.Type]; ok {
+		inverse, err := uc.ProductRelationRepository.FindRelation(tx, request.RelatedProductID, productID, inverseType)
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to find inverse product relation")
+			return nil, utils.WrapMessageAsError(constants.FailedCreateProductRelation, err)
+		}
+
+		if inverse == nil {
+			inverse = &entity.ProductRelation{
+				ID:               uuid.New(),
+				ProductID:        request.RelatedProductID,
+				RelatedProductID: productID,
+				Type:             inverseType,
+				CreatedBy:        actorID,
+			}
+			if err := uc.ProductRelationRepository.Create(tx.Omit("Product", "RelatedProduct"), inverse); err != nil {
+				uc.Log.WithError(err).Error("Failed to create inverse product relation")
+				return nil, utils.WrapMessageAsError(constants.FailedCreateProductRelation, err)
+			}
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for product relation creation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateProductRelation, err)
+	}
+
+	return converter.ToProductRelationResponse(relation), nil
+}
+
+func (uc *ProductRelationUseCase) DeleteProductRelation(ctx context.Context, productID uuid.UUID, relationID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	relation, err := uc.ProductRelationRepository.FindRelationById(tx, relationID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product relation by ID")
+		return utils.WrapMessageAsError(constants.FailedDeleteProductRelation, err)
+	}
+
+	if relation == nil || relation.ProductID != productID {
+		return utils.WrapMessageAsError(constants.ProductRelationNotFound)
+	}
+
+	if err := uc.ProductRelationRepository.Delete(tx, relation); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete product relation")
+		return utils.WrapMessageAsError(constants.FailedDeleteProductRelation, err)
+	}
+
+	if inverseType, ok := model.ProductRelationInverses[relation.Type]; ok {
+		if err := tx.Where("product_id = ? AND related_product_id = ? AND type = ?", relation.RelatedProductID, relation.ProductID, inverseType).
+			Delete(&entity.ProductRelation{}).Error; err != nil {
+			uc.Log.WithError(err).Error("Failed to delete inverse product relation")
+			return utils.WrapMessageAsError(constants.FailedDeleteProductRelation, err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for product relation deletion")
+		return utils.WrapMessageAsError(constants.FailedDeleteProductRelation, err)
+	}
+
+	return nil
+}
+
+func findRelatedProducts(db *gorm.DB, productRepository *repository.ProductRepository, productRelationRepository *repository.ProductRelationRepository, categoryRepository *repository.CategoryRepository, priceCampaignRepository *repository.PriceCampaignRepository, productIDs []uuid.UUID, types []string, limit int, locale string) ([]*model.RelatedProductResponse, error) {
+	relations, err := productRelationRepository.FindByProductIds(db, productIDs, types)
+	if err != nil || len(relations) == 0 {
+		return nil, err
+	}
+
+	relatedIDs := make([]uuid.UUID, 0, len(relations))
+	for _, relation := range relations {
+		relatedIDs = append(relatedIDs, relation.RelatedProductID)
+	}
+
+	products, err := productRepository.FindProductsByIds(db, relatedIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*entity.Product, len(products))
+	published := make([]*entity.Product, 0, len(products))
+	for i := range products {
+		if products[i].Status != model.ProductStatusPublished || slices.Contains(productIDs, products[i].ID) {
+			continue
+		}
+		byID[products[i].ID] = &products[i]
+		published = append(published, &products[i])
+	}
+
+	if err := resolveEffectivePrices(db, categoryRepository, priceCampaignRepository, published, time.Now()); err != nil {
+		return nil, err
+	}
+
+	for _, product := range published {
+		converter.LocalizeProduct(product, locale)
+	}
+
+	seen := map[string]bool{}
+	responses := make([]*model.RelatedProductResponse, 0, len(relations))
+	for i := range relations {
+		product, ok := byID[relations[i].RelatedProductID]
+		key := relations[i].Type + ":" + relations[i].RelatedProductID.String()
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		responses = append(responses, converter.ToRelatedProductResponse(&relations[i], product))
+		if limit > 0 && len(responses) >= limit {
+			break
+		}
+	}
+
+	return responses, nil
+}
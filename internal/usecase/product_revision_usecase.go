@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProductRevisionUseCase struct {
+	DB                        *gorm.DB
+	Log                       *logrus.Logger
+	ProductRepository         *repository.ProductRepository
+	ProductRevisionRepository *repository.ProductRevisionRepository
+	ProductUseCase            *ProductUseCase
+}
+
+func NewProductRevisionUsecase(db *gorm.DB, log *logrus.Logger, productRepository *repository.ProductRepository, productRevisionRepository *repository.ProductRevisionRepository, productUseCase *ProductUseCase) *ProductRevisionUseCase {
+	return &ProductRevisionUseCase{
+		DB:                        db,
+		Log:                       log,
+		ProductRepository:         productRepository,
+		ProductRevisionRepository: productRevisionRepository,
+		ProductUseCase:            productUseCase,
+	}
+}
+
+func (uc *ProductRevisionUseCase) GetProductRevisions(ctx context.Context, productID uuid.UUID) ([]*model.ProductRevisionResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	total, err := uc.ProductRepository.CountById(db.Unscoped(), productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to count product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductRevisions, err)
+	}
+
+	if total == 0 {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	revisions, err := uc.ProductRevisionRepository.FindByProductId(db, productID)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductRevisions, err)
+	}
+
+	responses := make([]*model.ProductRevisionResponse, 0, len(revisions))
+	for i := range revisions {
+		responses = append(responses, converter.ToProductRevisionResponse(&revisions[i]))
+	}
+
+	return responses, nil
+}
+
+func (uc *ProductRevisionUseCase) DiffProductRevisions(ctx context.Context, productID uuid.UUID, from, to int) (*model.ProductRevisionDiffResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	fromRevision, err := uc.ProductRevisionRepository.FindRevision(db, productID, from)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product revision")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductRevisionDiff, err)
+	}
+
+	toRevision, err := uc.ProductRevisionRepository.FindRevision(db, productID, to)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product revision")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductRevisionDiff, err)
+	}
+
+	if fromRevision == nil || toRevision == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductRevisionNotFound)
+	}
+
+	diff, _ := utils.DiffJSON(fromRevision.Snapshot, toRevision.Snapshot)
+
+	return &model.ProductRevisionDiffResponse{
+		From: from,
+		To:   to,
+		Diff: diff,
+	}, nil
+}
+
+func (uc *ProductRevisionUseCase) RollbackProductRevision(ctx context.Context, productID uuid.UUID, revision int, actorID uuid.UUID) (*model.ProductResponse, error) {
+	target, err := uc.ProductRevisionRepository.FindRevision(uc.DB.WithContext(ctx), productID, revision)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product revision")
+		return nil, utils.WrapMessageAsError(constants.FailedRollbackProductRevision, err)
+	}
+
+	if target == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductRevisionNotFound)
+	}
+
+	var snapshot model.ProductSnapshot
+	if err := json.Unmarshal(target.Snapshot, &snapshot); err != nil {
+		uc.Log.WithError(err).Error("Failed to decode product revision snapshot")
+		return nil, utils.WrapMessageAsError(constants.FailedRollbackProductRevision, err)
+	}
+
+	request := converter.ToRollbackProductRequest(&snapshot)
+	return uc.ProductUseCase.UpdateProduct(ctx, productID, request, actorID)
+}
+
+func recordProductRevision(ctx context.Context, tx *gorm.DB, productRevisionRepository *repository.ProductRevisionRepository, product *entity.Product, actorID uuid.UUID) error {
+	// Revisions are numbered from the latest one, so concurrent writers take
+	// the product row lock before reading it.
+	if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Take(&entity.Product{}, "id = ?", product.ID).Error; err != nil {
+		return err
+	}
+
+	latest, err := productRevisionRepository.FindLatest(tx, product.ID)
+	if err != nil {
+		return err
+	}
+
+	snapshot := converter.ToProductSnapshot(product)
+	revision := &entity.ProductRevision{
+		ID:        uuid.New(),
+		ProductID: product.ID,
+		Revision:  1,
+		Snapshot:  snapshot,
+		ActorID:   actorID,
+		RequestID: utils.RequestIDFromContext(ctx),
+	}
+
+	if latest != nil {
+		diff, changed := utils.DiffJSON(latest.Snapshot, snapshot)
+		if !changed {
+			return nil
+		}
+		revision.Revision = latest.Revision + 1
+		revision.Diff = diff
+	} else {
+		revision.Diff, _ = utils.DiffJSON(nil, snapshot)
+	}
+
+	return productRevisionRepository.Create(tx, revision)
+}
@@ -8,40 +8,70 @@ import (
 	"golectro-product/internal/model/converter"
 	"golectro-product/internal/repository"
 	"golectro-product/internal/utils"
+	"slices"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 
 	"github.com/sirupsen/logrus"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
 type ProductUseCase struct {
-	DB                     *gorm.DB
-	Log                    *logrus.Logger
-	Validate               *validator.Validate
-	ProductRepository      *repository.ProductRepository
-	ProductImageRepository *repository.ImageRepository
-	ElasticsearchUseCase   *ElasticsearchUseCase
+	DB                                *gorm.DB
+	Log                               *logrus.Logger
+	Validate                          *validator.Validate
+	ProductRepository                 *repository.ProductRepository
+	ProductImageRepository            *repository.ImageRepository
+	CategoryRepository                *repository.CategoryRepository
+	BrandRepository                   *repository.BrandRepository
+	CategorySpecRepository            *repository.CategorySpecRepository
+	ProductSlugRepository             *repository.ProductSlugRepository
+	ProductStatusTransitionRepository *repository.ProductStatusTransitionRepository
+	ProductRevisionRepository         *repository.ProductRevisionRepository
+	ProductPriceRepository            *repository.ProductPriceRepository
+	PriceCampaignRepository           *repository.PriceCampaignRepository
+	FlashSaleRepository               *repository.FlashSaleRepository
+	FlashSaleQuotaRepository          *repository.FlashSaleQuotaRepository
+	PriceListRepository               *repository.PriceListRepository
+	PriceTierRepository               *repository.PriceTierRepository
+	BundleComponentRepository         *repository.BundleComponentRepository
+	ProductRelationRepository         *repository.ProductRelationRepository
+	ElasticsearchUseCase              *ElasticsearchUseCase
 }
 
-func NewProductUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, productRepository *repository.ProductRepository, productImageRepository *repository.ImageRepository, elasticsearchUseCase *ElasticsearchUseCase) *ProductUseCase {
+func NewProductUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, productRepository *repository.ProductRepository, productImageRepository *repository.ImageRepository, categoryRepository *repository.CategoryRepository, brandRepository *repository.BrandRepository, categorySpecRepository *repository.CategorySpecRepository, productSlugRepository *repository.ProductSlugRepository, productStatusTransitionRepository *repository.ProductStatusTransitionRepository, productRevisionRepository *repository.ProductRevisionRepository, productPriceRepository *repository.ProductPriceRepository, priceCampaignRepository *repository.PriceCampaignRepository, flashSaleRepository *repository.FlashSaleRepository, flashSaleQuotaRepository *repository.FlashSaleQuotaRepository, priceListRepository *repository.PriceListRepository, priceTierRepository *repository.PriceTierRepository, bundleComponentRepository *repository.BundleComponentRepository, productRelationRepository *repository.ProductRelationRepository, elasticsearchUseCase *ElasticsearchUseCase) *ProductUseCase {
 	return &ProductUseCase{
-		DB:                     db,
-		Log:                    log,
-		Validate:               validate,
-		ProductRepository:      productRepository,
-		ProductImageRepository: productImageRepository,
-		ElasticsearchUseCase:   elasticsearchUseCase,
+		DB:                                db,
+		Log:                               log,
+		Validate:                          validate,
+		ProductRepository:                 productRepository,
+		ProductImageRepository:            productImageRepository,
+		CategoryRepository:                categoryRepository,
+		BrandRepository:                   brandRepository,
+		CategorySpecRepository:            categorySpecRepository,
+		ProductSlugRepository:             productSlugRepository,
+		ProductStatusTransitionRepository: productStatusTransitionRepository,
+		ProductRevisionRepository:         productRevisionRepository,
+		ProductPriceRepository:            productPriceRepository,
+		PriceCampaignRepository:           priceCampaignRepository,
+		FlashSaleRepository:               flashSaleRepository,
+		FlashSaleQuotaRepository:          flashSaleQuotaRepository,
+		PriceListRepository:               priceListRepository,
+		PriceTierRepository:               priceTierRepository,
+		BundleComponentRepository:         bundleComponentRepository,
+		ProductRelationRepository:         productRelationRepository,
+		ElasticsearchUseCase:              elasticsearchUseCase,
 	}
 }
 
-func (uc *ProductUseCase) GetAllProducts(ctx context.Context, limit, offset int) ([]entity.Product, int64, error) {
+func (uc *ProductUseCase) GetAllProducts(ctx context.Context, limit, offset int, statuses []string) ([]entity.Product, int64, error) {
 	tx := uc.DB.WithContext(ctx).Begin()
 	defer tx.Rollback()
 
-	products, total, err := uc.ProductRepository.GetAll(tx, limit, offset)
+	products, total, err := uc.ProductRepository.GetAll(tx, limit, offset, statuses)
 	if err != nil {
 		uc.Log.WithError(err).Error("Failed to get all products")
 		return nil, 0, err
@@ -49,25 +79,273 @@ func (uc *ProductUseCase) GetAllProducts(ctx context.Context, limit, offset int)
 	return products, total, nil
 }
 
-func (uc *ProductUseCase) GetProductByID(ctx context.Context, productID uuid.UUID) (*model.ProductResponse, error) {
-	product, err := uc.ProductRepository.FindProductById(uc.DB.WithContext(ctx), productID)
+func (uc *ProductUseCase) GetProductByID(ctx context.Context, productID uuid.UUID, locale string) (*model.ProductResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	product, err := uc.ProductRepository.FindProductById(db, productID)
 	if err != nil {
 		uc.Log.WithError(err).Error("Failed to find product by ID")
 		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
 	}
 
-	if product == nil {
+	if product == nil || product.Status != model.ProductStatusPublished {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	return uc.toProductDetail(db, product, locale)
+}
+
+func (uc *ProductUseCase) GetProductIncludingDeleted(ctx context.Context, productID uuid.UUID) (*model.ProductResponse, error) {
+	product, err := uc.ProductRepository.FindProductByIdWithDeleted(uc.DB.WithContext(ctx), productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+
+	if product == nil || (product.Status != model.ProductStatusPublished && !product.DeletedAt.Valid) {
 		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
 	}
 
+	if err := resolveEffectivePrices(uc.DB.WithContext(ctx), uc.CategoryRepository, uc.PriceCampaignRepository, []*entity.Product{product}, time.Now()); err != nil {
+		uc.Log.WithError(err).Error("Failed to resolve effective price")
+		return nil, utils.WrapMessageAsError(constants.FailedResolveEffectivePrice, err)
+	}
+
 	return converter.ToProductResponse(product), nil
 }
 
+func (uc *ProductUseCase) GetProductBySlug(ctx context.Context, slug string, locale string) (*model.ProductResponse, string, error) {
+	db := uc.DB.WithContext(ctx)
+
+	product, err := uc.ProductRepository.FindProductBySlug(db, slug)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by slug")
+		return nil, "", utils.WrapMessageAsError(constants.FailedGetProductBySlug, err)
+	}
+
+	if product != nil {
+		if product.Status != model.ProductStatusPublished {
+			return nil, "", utils.WrapMessageAsError(constants.ProductNotFound)
+		}
+
+		response, err := uc.toProductDetail(db, product, locale)
+		return response, "", err
+	}
+
+	history, err := uc.ProductSlugRepository.FindBySlug(db, slug)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product slug history")
+		return nil, "", utils.WrapMessageAsError(constants.FailedGetProductBySlug, err)
+	}
+
+	if history == nil {
+		return nil, "", utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	product, err = uc.ProductRepository.FindProductById(db, history.ProductID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, "", utils.WrapMessageAsError(constants.FailedGetProductBySlug, err)
+	}
+
+	if product == nil || product.Slug == "" || product.Status != model.ProductStatusPublished {
+		return nil, "", utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	return nil, product.Slug, nil
+}
+
+func (uc *ProductUseCase) toProductDetail(db *gorm.DB, product *entity.Product, locale string) (*model.ProductResponse, error) {
+	if err := resolveEffectivePrices(db, uc.CategoryRepository, uc.PriceCampaignRepository, []*entity.Product{product}, time.Now()); err != nil {
+		uc.Log.WithError(err).Error("Failed to resolve effective price")
+		return nil, utils.WrapMessageAsError(constants.FailedResolveEffectivePrice, err)
+	}
+
+	categoryIDs := make([]uuid.UUID, 0, len(product.Categories))
+	for _, category := range product.Categories {
+		categoryIDs = append(categoryIDs, category.ID)
+	}
+
+	specs, err := resolveSpecSchema(db, uc.CategoryRepository, uc.CategorySpecRepository, categoryIDs)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to resolve category specs")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+
+	response := converter.ToLocalizedProductResponse(product, locale)
+	response.SpecLabels = converter.ToSpecLabels(specs, locale)
+	uc.attachLowestPrices(db, response)
+
+	sale, err := uc.FlashSaleRepository.FindLiveByProductId(db, product.ID, time.Now())
+	if err != nil {
+		uc.Log.WithError(err).Warn("Failed to find live flash sale")
+	} else if sale != nil && sale.SalePrice < product.EffectivePrice {
+		response.FlashSale = converter.ToFlashSaleSummary(sale, flashSaleRemaining(db.Statement.Context, uc.Log, uc.FlashSaleQuotaRepository, sale))
+	}
+
+	if product.Type == model.ProductTypeBundle {
+		components, err := uc.BundleComponentRepository.FindByBundleIds(db, []uuid.UUID{product.ID})
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to find bundle components")
+			return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+		}
+
+		response.Components, err = bundleComponentResponses(db, uc.ProductRepository, components)
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to resolve bundle components")
+			return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+		}
+	}
+
+	tiers, err := uc.PriceTierRepository.FindDefaultByProductId(db, product.ID)
+	if err != nil {
+		uc.Log.WithError(err).Warn("Failed to find price tiers")
+	} else if len(tiers) > 0 {
+		response.PriceTiers = converter.ToPriceTierResponses(tiers)
+	}
+
+	related, err := findRelatedProducts(db, uc.ProductRepository, uc.ProductRelationRepository, uc.CategoryRepository, uc.PriceCampaignRepository, []uuid.UUID{product.ID}, nil, 0, locale)
+	if err != nil {
+		uc.Log.WithError(err).Warn("Failed to find related products")
+	} else if len(related) > 0 {
+		response.Related = make(map[string][]*model.RelatedProductResponse)
+		for _, item := range related {
+			response.Related[item.RelationType] = append(response.Related[item.RelationType], item)
+		}
+	}
+
+	return response, nil
+}
+
+func (uc *ProductUseCase) QuotePrice(ctx context.Context, productID uuid.UUID, quantity int, customerGroup string) (*model.PriceQuoteResponse, error) {
+	if quantity <= 0 {
+		return nil, utils.WrapMessageAsError(constants.InvalidQuoteQuantity)
+	}
+
+	db := uc.DB.WithContext(ctx)
+
+	product, err := uc.ProductRepository.FindProductById(db, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedQuotePrice, err)
+	}
+
+	if product == nil || product.Status != model.ProductStatusPublished {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	if err := resolveEffectivePrices(db, uc.CategoryRepository, uc.PriceCampaignRepository, []*entity.Product{product}, time.Now()); err != nil {
+		uc.Log.WithError(err).Error("Failed to resolve effective price")
+		return nil, utils.WrapMessageAsError(constants.FailedResolveEffectivePrice, err)
+	}
+
+	quote, err := quoteProductPrice(db, uc.PriceListRepository, uc.PriceTierRepository, product, quantity, customerGroup)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to quote product price")
+		return nil, utils.WrapMessageAsError(constants.FailedQuotePrice, err)
+	}
+
+	return quote, nil
+}
+
+func (uc *ProductUseCase) GetRelatedProducts(ctx context.Context, productIDs []uuid.UUID, types []string, limit int, locale string) ([]*model.RelatedProductResponse, error) {
+	for _, relationType := range types {
+		if !slices.Contains(model.ProductRelationTypes, relationType) {
+			return nil, utils.WrapMessageAsError(constants.InvalidProductRelationType)
+		}
+	}
+
+	related, err := findRelatedProducts(uc.DB.WithContext(ctx), uc.ProductRepository, uc.ProductRelationRepository, uc.CategoryRepository, uc.PriceCampaignRepository, productIDs, types, limit, locale)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find related products")
+		return nil, utils.WrapMessageAsError(constants.FailedGetRelatedProducts, err)
+	}
+
+	return related, nil
+}
+
+func (uc *ProductUseCase) attachLowestPrices(db *gorm.DB, responses ...*model.ProductResponse) {
+	productIDs := make([]uuid.UUID, 0, len(responses))
+	for _, response := range responses {
+		productIDs = append(productIDs, response.ID)
+	}
+
+	lowest, err := uc.ProductPriceRepository.FindLowestSince(db, productIDs, time.Now().AddDate(0, 0, -model.LowestPriceWindowDays))
+	if err != nil {
+		uc.Log.WithError(err).Warn("Failed to resolve lowest product prices")
+		return
+	}
+
+	for _, response := range responses {
+		if price, ok := lowest[response.ID]; ok {
+			response.LowestPrice30d = &price
+		}
+	}
+}
+
+func (uc *ProductUseCase) GetProductPriceHistory(ctx context.Context, productID uuid.UUID) (*model.ProductPriceHistoryResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	product, err := uc.ProductRepository.FindProductById(db, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductPriceHistory, err)
+	}
+
+	if product == nil || product.Status != model.ProductStatusPublished {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	prices, err := uc.ProductPriceRepository.FindByProductId(db, productID)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductPriceHistory, err)
+	}
+
+	lowest, err := uc.ProductPriceRepository.FindLowestSince(db, []uuid.UUID{productID}, time.Now().AddDate(0, 0, -model.LowestPriceWindowDays))
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductPriceHistory, err)
+	}
+
+	var lowestPrice *int64
+	if price, ok := lowest[productID]; ok {
+		lowestPrice = &price
+	}
+
+	return converter.ToProductPriceHistoryResponse(product, prices, lowestPrice), nil
+}
+
+func (uc *ProductUseCase) recordPrice(tx *gorm.DB, product *entity.Product, actorID uuid.UUID) error {
+	now := time.Now()
+
+	lowest, err := uc.ProductPriceRepository.FindLowestSince(tx, []uuid.UUID{product.ID}, now.AddDate(0, 0, -model.LowestPriceWindowDays))
+	if err != nil {
+		return err
+	}
+
+	if price, ok := lowest[product.ID]; ok && product.Price < price {
+		product.PriceDroppedAt = &now
+	} else {
+		product.PriceDroppedAt = nil
+	}
+
+	if err := tx.Model(product).UpdateColumn("price_dropped_at", product.PriceDroppedAt).Error; err != nil {
+		return err
+	}
+
+	return uc.ProductPriceRepository.Create(tx, &entity.ProductPrice{
+		ID:            uuid.New(),
+		ProductID:     product.ID,
+		Price:         product.Price,
+		EffectiveFrom: now,
+		ActorID:       actorID,
+	})
+}
+
 func (uc *ProductUseCase) GetProductsByIDs(ctx context.Context, productIDs []uuid.UUID) ([]*model.ProductResponse, error) {
 	tx := uc.DB.WithContext(ctx).Begin()
 	defer tx.Rollback()
 
-	products, err := uc.ProductRepository.FindProductsByIds(tx, productIDs)
+	products, err := uc.ProductRepository.FindProductsByIdsWithDeleted(tx, productIDs)
 	if err != nil {
 		uc.Log.WithError(err).Error("Failed to find products by IDs")
 		return nil, utils.WrapMessageAsError(constants.FailedGetProductsByIDs, err)
@@ -77,11 +355,30 @@ func (uc *ProductUseCase) GetProductsByIDs(ctx context.Context, productIDs []uui
 		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
 	}
 
+	var visible []*entity.Product
+	for i := range products {
+		if products[i].Status != model.ProductStatusPublished && !products[i].DeletedAt.Valid {
+			continue
+		}
+		visible = append(visible, &products[i])
+	}
+
+	if err := resolveEffectivePrices(tx, uc.CategoryRepository, uc.PriceCampaignRepository, visible, time.Now()); err != nil {
+		uc.Log.WithError(err).Error("Failed to resolve effective prices")
+		return nil, utils.WrapMessageAsError(constants.FailedResolveEffectivePrice, err)
+	}
+
 	var productResponses []*model.ProductResponse
-	for _, product := range products {
-		productResponses = append(productResponses, converter.ToProductResponse(&product))
+	for _, product := range visible {
+		productResponses = append(productResponses, converter.ToProductResponse(product))
 	}
 
+	if len(productResponses) == 0 {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	uc.attachLowestPrices(tx, productResponses...)
+
 	return productResponses, nil
 }
 
@@ -111,26 +408,126 @@ func (uc *ProductUseCase) CreateProduct(ctx context.Context, request *model.Prod
 		return nil, utils.WrapMessageAsError(message)
 	}
 
+	categories, err := uc.findCategories(tx, request.CategoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	brand, err := uc.findBrand(tx, request.BrandID)
+	if err != nil {
+		return nil, err
+	}
+
+	specs, err := uc.validateSpecs(tx, categories, request.Specs)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.CompareAtPrice != nil && *request.CompareAtPrice <= request.Price {
+		return nil, utils.WrapMessageAsError(constants.InvalidCompareAtPrice)
+	}
+
+	productType := request.Type
+	if productType == "" {
+		productType = model.ProductTypeSimple
+	}
+
+	if productType != model.ProductTypeBundle && len(request.Components) > 0 {
+		return nil, utils.WrapMessageAsError(constants.BundleComponentsNotAllowed)
+	}
+
+	quantity := request.Quantity
+	if productType == model.ProductTypeBundle {
+		quantity = 0
+	}
+
 	productID := uuid.New()
 	entityProduct := &entity.Product{
-		ID:          productID,
-		Name:        request.Name,
-		Description: request.Description,
-		Category:    request.Category,
-		Brand:       request.Brand,
-		Color:       request.Color,
-		Specs:       request.Specs,
-		Price:       request.Price,
-		CreatedBy:   userID,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
-	}
-
-	if err := tx.Create(entityProduct).Error; err != nil {
+		ID:             productID,
+		Name:           request.Name,
+		Description:    request.Description,
+		Translations:   converter.ToProductTranslations(request.Name, request.Description, request.Translations),
+		Category:       converter.ToCategorySlugs(categories),
+		Categories:     categories,
+		BrandID:        &brand.ID,
+		Brand:          brand.Name,
+		Color:          request.Color,
+		Specs:          specs,
+		Price:          request.Price,
+		Currency:       model.BaseCurrency,
+		CompareAtPrice: request.CompareAtPrice,
+		EffectivePrice: request.Price,
+		Type:           productType,
+		Quantity:       quantity,
+		Status:         model.ProductStatusDraft,
+		CreatedBy:      userID,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	if err := uc.assignSlug(tx, entityProduct, request.Slug); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Omit("Categories.*").Create(entityProduct).Error; err != nil {
 		uc.Log.WithError(err).Error("Failed to create product")
 		return nil, utils.WrapMessageAsError(constants.FailedToCreateProduct, err)
 	}
 
+	if err := uc.ProductSlugRepository.Record(tx, entityProduct.ID, entityProduct.Slug); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product slug")
+		return nil, utils.WrapMessageAsError(constants.FailedToCreateProduct, err)
+	}
+
+	if err := uc.ProductStatusTransitionRepository.Create(tx, &entity.ProductStatusTransition{
+		ID:        uuid.New(),
+		ProductID: entityProduct.ID,
+		ToStatus:  entityProduct.Status,
+		ActorID:   userID,
+	}); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product status transition")
+		return nil, utils.WrapMessageAsError(constants.FailedToCreateProduct, err)
+	}
+
+	if err := uc.recordPrice(tx, entityProduct, userID); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product price")
+		return nil, utils.WrapMessageAsError(constants.FailedToCreateProduct, err)
+	}
+
+	if productType == model.ProductTypeBundle {
+		components, err := buildBundleComponents(tx, uc.ProductRepository, entityProduct, request.Components)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := uc.BundleComponentRepository.Replace(tx, entityProduct.ID, components); err != nil {
+			uc.Log.WithError(err).Error("Failed to create bundle components")
+			return nil, utils.WrapMessageAsError(constants.FailedToCreateProduct, err)
+		}
+
+		stock, err := findComponentStock(tx, bundleComponentIDs(components), false)
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to find bundle component stock")
+			return nil, utils.WrapMessageAsError(constants.FailedToCreateProduct, err)
+		}
+
+		entityProduct.Quantity = deriveBundleQuantity(components, stock)
+		if err := tx.Model(entityProduct).UpdateColumn("quantity", entityProduct.Quantity).Error; err != nil {
+			uc.Log.WithError(err).Error("Failed to set bundle stock")
+			return nil, utils.WrapMessageAsError(constants.FailedToCreateProduct, err)
+		}
+	}
+
+	if _, err := refreshEffectivePrices(tx, uc.CategoryRepository, uc.PriceCampaignRepository, []*entity.Product{entityProduct}, time.Now()); err != nil {
+		uc.Log.WithError(err).Error("Failed to refresh effective price")
+		return nil, utils.WrapMessageAsError(constants.FailedToCreateProduct, err)
+	}
+
+	if err := recordProductRevision(ctx, tx, uc.ProductRevisionRepository, entityProduct, userID); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product revision")
+		return nil, utils.WrapMessageAsError(constants.FailedToCreateProduct, err)
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		uc.Log.WithError(err).Error("Failed to commit transaction")
 		return nil, utils.WrapMessageAsError(constants.FailedCreateProduct, err)
@@ -144,7 +541,7 @@ func (uc *ProductUseCase) CreateProduct(ctx context.Context, request *model.Prod
 	return converter.ToProductResponse(entityProduct), nil
 }
 
-func (uc *ProductUseCase) UpdateProduct(ctx context.Context, productID uuid.UUID, request *model.UpdateProductRequest) (*model.ProductResponse, error) {
+func (uc *ProductUseCase) UpdateProduct(ctx context.Context, productID uuid.UUID, request *model.UpdateProductRequest, actorID uuid.UUID) (*model.ProductResponse, error) {
 	tx := uc.DB.WithContext(ctx).Begin()
 	defer tx.Rollback()
 
@@ -163,17 +560,45 @@ func (uc *ProductUseCase) UpdateProduct(ctx context.Context, productID uuid.UUID
 		return nil, utils.WrapMessageAsError(message)
 	}
 
+	previousName := product.Name
+	previousPrice := product.Price
+	previousQuantity := product.Quantity
 	if request.Name != nil {
 		product.Name = *request.Name
 	}
 	if request.Description != nil {
 		product.Description = *request.Description
 	}
-	if request.Category != nil {
-		product.Category = *request.Category
+	if request.Name != nil || request.Description != nil || request.Translations != nil {
+		translations := request.Translations
+		if translations == nil {
+			translations = converter.ToProductTranslationMap(product.Translations)
+		}
+		product.Translations = converter.ToProductTranslations(product.Name, product.Description, translations)
 	}
-	if request.Brand != nil {
-		product.Brand = *request.Brand
+	if request.CategoryIDs != nil {
+		categories, err := uc.findCategories(tx, *request.CategoryIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tx.Model(product).Association("Categories").Replace(categories); err != nil {
+			uc.Log.WithError(err).Error("Failed to replace product categories")
+			return nil, utils.WrapMessageAsError(constants.FailedUpdateProduct, err)
+		}
+		product.Categories = categories
+		product.Category = converter.ToCategorySlugs(categories)
+	}
+	if request.BrandID != nil && *request.BrandID == uuid.Nil {
+		product.BrandID = nil
+		product.Brand = ""
+	} else if request.BrandID != nil {
+		brand, err := uc.findBrand(tx, *request.BrandID)
+		if err != nil {
+			return nil, err
+		}
+		product.BrandID = &brand.ID
+		product.Brand = brand.Name
 	}
 	if request.Color != nil {
 		product.Color = *request.Color
@@ -184,13 +609,75 @@ func (uc *ProductUseCase) UpdateProduct(ctx context.Context, productID uuid.UUID
 	if request.Price != nil {
 		product.Price = *request.Price
 	}
+	if request.CompareAtPrice != nil {
+		product.CompareAtPrice = request.CompareAtPrice
+		if *request.CompareAtPrice == 0 {
+			product.CompareAtPrice = nil
+		}
+	}
+	if product.CompareAtPrice != nil && *product.CompareAtPrice <= product.Price {
+		return nil, utils.WrapMessageAsError(constants.InvalidCompareAtPrice)
+	}
+	if request.Quantity != nil {
+		if product.Type == model.ProductTypeBundle {
+			return nil, utils.WrapMessageAsError(constants.BundleQuantityDerived)
+		}
+		product.Quantity = *request.Quantity
+	}
+	if request.CategoryIDs != nil || request.Specs != nil {
+		specs, err := uc.validateSpecs(tx, product.Categories, product.Specs)
+		if err != nil {
+			return nil, err
+		}
+		product.Specs = specs
+	}
+	if request.Slug != nil {
+		if err := uc.assignSlug(tx, product, *request.Slug); err != nil {
+			return nil, err
+		}
+	} else if product.Name != previousName || product.Slug == "" {
+		if err := uc.assignSlug(tx, product, ""); err != nil {
+			return nil, err
+		}
+	}
 	product.UpdatedAt = time.Now()
 
-	if err := tx.Save(product).Error; err != nil {
+	if err := tx.Omit("Categories").Save(product).Error; err != nil {
 		uc.Log.WithError(err).Error("Failed to update product")
 		return nil, utils.WrapMessageAsError(constants.FailedUpdateProduct, err)
 	}
 
+	if err := uc.ProductSlugRepository.Record(tx, product.ID, product.Slug); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product slug")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProduct, err)
+	}
+
+	if product.Price != previousPrice {
+		if err := uc.recordPrice(tx, product, actorID); err != nil {
+			uc.Log.WithError(err).Error("Failed to record product price")
+			return nil, utils.WrapMessageAsError(constants.FailedUpdateProduct, err)
+		}
+	}
+
+	if _, err := refreshEffectivePrices(tx, uc.CategoryRepository, uc.PriceCampaignRepository, []*entity.Product{product}, time.Now()); err != nil {
+		uc.Log.WithError(err).Error("Failed to refresh effective price")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProduct, err)
+	}
+
+	var bundles []*entity.Product
+	if product.Quantity != previousQuantity {
+		bundles, err = refreshBundleStock(tx, uc.ProductRepository, uc.BundleComponentRepository, []uuid.UUID{product.ID})
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to sync bundle stock")
+			return nil, utils.WrapMessageAsError(constants.FailedSyncBundleStock, err)
+		}
+	}
+
+	if err := recordProductRevision(ctx, tx, uc.ProductRevisionRepository, product, actorID); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product revision")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProduct, err)
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		uc.Log.WithError(err).Error("Failed to commit transaction for product update")
 		return nil, utils.WrapMessageAsError(constants.FailedUpdateProduct, err)
@@ -201,18 +688,362 @@ func (uc *ProductUseCase) UpdateProduct(ctx context.Context, productID uuid.UUID
 		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductInElasticsearch, err)
 	}
 
+	uc.reindexProducts(bundles)
+
+	return converter.ToProductResponse(product), nil
+}
+
+func (uc *ProductUseCase) reindexProducts(products []*entity.Product) {
+	for _, product := range products {
+		if err := uc.ElasticsearchUseCase.InsertDocument(product.ID, product); err != nil {
+			uc.Log.WithError(err).Warnf("Failed to reindex product %s after stock change", product.ID)
+		}
+	}
+}
+
+func (uc *ProductUseCase) findCategories(tx *gorm.DB, categoryIDs []uuid.UUID) ([]entity.Category, error) {
+	if len(categoryIDs) == 0 {
+		return []entity.Category{}, nil
+	}
+
+	categories, err := uc.CategoryRepository.FindCategoriesByIds(tx, categoryIDs)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find categories by IDs")
+		return nil, utils.WrapMessageAsError(constants.FailedGetCategories, err)
+	}
+
+	unique := make(map[uuid.UUID]bool, len(categoryIDs))
+	for _, id := range categoryIDs {
+		unique[id] = true
+	}
+
+	if len(categories) != len(unique) {
+		return nil, utils.WrapMessageAsError(constants.CategoryNotFound)
+	}
+
+	return categories, nil
+}
+
+func (uc *ProductUseCase) findBrand(tx *gorm.DB, brandID uuid.UUID) (*entity.Brand, error) {
+	brand, err := uc.BrandRepository.FindBrandById(tx, brandID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find brand by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetBrandByID, err)
+	}
+
+	if brand == nil {
+		return nil, utils.WrapMessageAsError(constants.BrandNotFound)
+	}
+
+	return brand, nil
+}
+
+func (uc *ProductUseCase) UpdateProductStatus(ctx context.Context, productID uuid.UUID, request *model.ProductStatusRequest, actorID uuid.UUID) (*model.ProductResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	product, err := uc.ProductRepository.FindProductById(tx, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+
+	if product == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	if !slices.Contains(model.ProductStatusTransitions[product.Status], request.Status) {
+		return nil, utils.WrapMessageAsError(constants.InvalidProductStatusTransition)
+	}
+
+	transition := &entity.ProductStatusTransition{
+		ID:         uuid.New(),
+		ProductID:  product.ID,
+		FromStatus: product.Status,
+		ToStatus:   request.Status,
+		ActorID:    actorID,
+		Note:       request.Note,
+	}
+
+	product.Status = request.Status
+	product.UpdatedAt = time.Now()
+
+	if err := tx.Model(product).UpdateColumns(map[string]any{
+		"status":     product.Status,
+		"updated_at": product.UpdatedAt,
+	}).Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to update product status")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductStatus, err)
+	}
+
+	if err := uc.ProductStatusTransitionRepository.Create(tx, transition); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product status transition")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductStatus, err)
+	}
+
+	if err := recordProductRevision(ctx, tx, uc.ProductRevisionRepository, product, actorID); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product revision")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductStatus, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for product status update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductStatus, err)
+	}
+
+	if err := uc.ElasticsearchUseCase.InsertDocument(product.ID, product); err != nil {
+		uc.Log.WithError(err).Error("Failed to update product in Elasticsearch")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductInElasticsearch, err)
+	}
+
+	return converter.ToProductResponse(product), nil
+}
+
+func (uc *ProductUseCase) UpdateProductSchedule(ctx context.Context, productID uuid.UUID, request *model.ProductScheduleRequest, actorID uuid.UUID) (*model.ProductResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	product, err := uc.ProductRepository.FindProductById(tx, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+
+	if product == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	if request.PublishAt != nil && request.UnpublishAt != nil && !request.UnpublishAt.After(*request.PublishAt) {
+		return nil, utils.WrapMessageAsError(constants.InvalidProductSchedule)
+	}
+
+	product.PublishAt = request.PublishAt
+	product.UnpublishAt = request.UnpublishAt
+	product.UpdatedAt = time.Now()
+
+	if err := tx.Model(product).Select("publish_at", "unpublish_at", "updated_at").Updates(product).Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to update product schedule")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductSchedule, err)
+	}
+
+	if err := recordProductRevision(ctx, tx, uc.ProductRevisionRepository, product, actorID); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product revision")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductSchedule, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for product schedule update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductSchedule, err)
+	}
+
+	if err := uc.ElasticsearchUseCase.InsertDocument(product.ID, product); err != nil {
+		uc.Log.WithError(err).Error("Failed to update product in Elasticsearch")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductInElasticsearch, err)
+	}
+
 	return converter.ToProductResponse(product), nil
 }
 
-func (uc *ProductUseCase) DeleteProduct(ctx context.Context, product *entity.Product) error {
+func (uc *ProductUseCase) RunScheduledTransitions(ctx context.Context, now time.Time) (int, error) {
+	db := uc.DB.WithContext(ctx)
+
+	due, err := uc.ProductRepository.FindDueForPublish(db, now)
+	if err != nil {
+		return 0, err
+	}
+
+	expired, err := uc.ProductRepository.FindDueForUnpublish(db, now)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for i := range due {
+		due[i].PublishAt = nil
+		ok, err := uc.applyScheduledTransition(ctx, &due[i], model.ProductStatusPublished, "publish_at")
+		if err != nil {
+			uc.Log.WithError(err).Errorf("Failed to publish scheduled product %s", due[i].ID)
+			continue
+		}
+		if ok {
+			applied++
+		}
+	}
+
+	for i := range expired {
+		expired[i].UnpublishAt = nil
+		ok, err := uc.applyScheduledTransition(ctx, &expired[i], model.ProductStatusArchived, "unpublish_at")
+		if err != nil {
+			uc.Log.WithError(err).Errorf("Failed to unpublish scheduled product %s", expired[i].ID)
+			continue
+		}
+		if ok {
+			applied++
+		}
+	}
+
+	return applied, nil
+}
+
+func (uc *ProductUseCase) applyScheduledTransition(ctx context.Context, product *entity.Product, status string, scheduleColumn string) (bool, error) {
 	tx := uc.DB.WithContext(ctx).Begin()
 	defer tx.Rollback()
 
+	transition := &entity.ProductStatusTransition{
+		ID:         uuid.New(),
+		ProductID:  product.ID,
+		FromStatus: product.Status,
+		ToStatus:   status,
+		ActorID:    uuid.Nil,
+		Note:       "scheduled by " + scheduleColumn,
+	}
+
+	product.Status = status
+	product.UpdatedAt = time.Now()
+
+	result := tx.Model(&entity.Product{}).
+		Where("id = ? AND status = ?", product.ID, transition.FromStatus).
+		UpdateColumns(map[string]any{
+			"status":       product.Status,
+			scheduleColumn: nil,
+			"updated_at":   product.UpdatedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	if err := uc.ProductStatusTransitionRepository.Create(tx, transition); err != nil {
+		return false, err
+	}
+
+	if err := recordProductRevision(ctx, tx, uc.ProductRevisionRepository, product, uuid.Nil); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+
+	if err := uc.ElasticsearchUseCase.InsertDocument(product.ID, product); err != nil {
+		uc.Log.WithError(err).Warnf("Failed to reindex product %s after scheduled transition", product.ID)
+	}
+
+	return true, nil
+}
+
+func (uc *ProductUseCase) GetProductStatusTransitions(ctx context.Context, productID uuid.UUID) ([]*model.ProductStatusTransitionResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	total, err := uc.ProductRepository.CountById(db, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to count product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductStatusTransitions, err)
+	}
+
+	if total == 0 {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	transitions, err := uc.ProductStatusTransitionRepository.FindByProductId(db, productID)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductStatusTransitions, err)
+	}
+
+	responses := make([]*model.ProductStatusTransitionResponse, 0, len(transitions))
+	for i := range transitions {
+		responses = append(responses, converter.ToProductStatusTransitionResponse(&transitions[i]))
+	}
+
+	return responses, nil
+}
+
+func (uc *ProductUseCase) assignSlug(tx *gorm.DB, product *entity.Product, requested string) error {
+	taken := func(slug string) (bool, error) {
+		return uc.ProductSlugRepository.IsTaken(tx, slug, product.ID)
+	}
+
+	if requested == "" {
+		slug, err := utils.UniqueSlug(product.Name, taken)
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to generate product slug")
+			return err
+		}
+		product.Slug = slug
+		return nil
+	}
+
+	slug := utils.Slugify(requested)
+	if slug == "" {
+		return utils.WrapMessageAsError(constants.InvalidProductSlug)
+	}
+
+	exists, err := taken(slug)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to check product slug")
+		return err
+	}
+
+	if exists {
+		return utils.WrapMessageAsError(constants.ProductSlugAlreadyExists)
+	}
+
+	product.Slug = slug
+	return nil
+}
+
+func (uc *ProductUseCase) validateSpecs(tx *gorm.DB, categories []entity.Category, specs datatypes.JSON) (datatypes.JSON, error) {
+	categoryIDs := make([]uuid.UUID, 0, len(categories))
+	for _, category := range categories {
+		categoryIDs = append(categoryIDs, category.ID)
+	}
+
+	schema, err := resolveSpecSchema(tx, uc.CategoryRepository, uc.CategorySpecRepository, categoryIDs)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to resolve category specs")
+		return nil, utils.WrapMessageAsError(constants.FailedGetCategorySpecs, err)
+	}
+
+	normalized, message := utils.ValidateSpecs(schema, specs)
+	if message != nil {
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	return normalized, nil
+}
+
+func (uc *ProductUseCase) DeleteProduct(ctx context.Context, product *entity.Product, actorID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	bundles, err := uc.BundleComponentRepository.CountActiveBundlesByComponentId(tx, product.ID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to count bundles using product")
+		return utils.WrapMessageAsError(constants.FailedDeleteProduct, err)
+	}
+
+	if bundles > 0 {
+		return utils.WrapMessageAsError(constants.ProductUsedInBundle)
+	}
+
 	if err := uc.ProductRepository.Delete(tx, product); err != nil {
 		uc.Log.WithError(err).Error("Failed to delete product")
 		return utils.WrapMessageAsError(constants.FailedDeleteProduct, err)
 	}
 
+	if err := recordProductRevision(ctx, tx, uc.ProductRevisionRepository, product, actorID); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product revision")
+		return utils.WrapMessageAsError(constants.FailedDeleteProduct, err)
+	}
+
 	if err := uc.ElasticsearchUseCase.DeleteDocumentByID(product.ID.String()); err != nil {
 		uc.Log.WithError(err).Error("Failed to delete product from Elasticsearch")
 		return utils.WrapMessageAsError(constants.FailedDeleteProductFromElasticsearch, err)
@@ -226,6 +1057,48 @@ func (uc *ProductUseCase) DeleteProduct(ctx context.Context, product *entity.Pro
 	return nil
 }
 
+func (uc *ProductUseCase) RestoreProduct(ctx context.Context, productID uuid.UUID, actorID uuid.UUID) (*model.ProductResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	product, err := uc.ProductRepository.FindProductByIdWithDeleted(tx, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+
+	if product == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	if !product.DeletedAt.Valid {
+		return nil, utils.WrapMessageAsError(constants.ProductNotDeleted)
+	}
+
+	if err := tx.Unscoped().Model(product).UpdateColumn("deleted_at", nil).Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to restore product")
+		return nil, utils.WrapMessageAsError(constants.FailedRestoreProduct, err)
+	}
+	product.DeletedAt = gorm.DeletedAt{}
+
+	if err := recordProductRevision(ctx, tx, uc.ProductRevisionRepository, product, actorID); err != nil {
+		uc.Log.WithError(err).Error("Failed to record product revision")
+		return nil, utils.WrapMessageAsError(constants.FailedRestoreProduct, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for product restore")
+		return nil, utils.WrapMessageAsError(constants.FailedRestoreProduct, err)
+	}
+
+	if err := uc.ElasticsearchUseCase.InsertDocument(product.ID, product); err != nil {
+		uc.Log.WithError(err).Error("Failed to insert product into Elasticsearch")
+		return nil, utils.WrapMessageAsError(constants.FailedInsertProductToElasticsearch, err)
+	}
+
+	return converter.ToProductResponse(product), nil
+}
+
 func (uc *ProductUseCase) UploadProductImages(ctx context.Context, productID uuid.UUID, images []map[string]any) (*model.UploadFilesResponse, error) {
 	tx := uc.DB.WithContext(ctx).Begin()
 	defer tx.Rollback()
@@ -235,24 +1108,34 @@ func (uc *ProductUseCase) UploadProductImages(ctx context.Context, productID uui
 		uc.Log.WithError(err).Error("Failed to find product by ID")
 		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID)
 	}
+	if product == nil {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
 
+	var uploads []model.UploadedImage
 	for _, img := range images {
-		fileName, ok := img["file_name"].(string)
-		if !ok || fileName == "" {
-			continue
-		}
-
-		productImage := entity.ProductImage{
-			ID:          uuid.New(),
-			ProductID:   product.ID,
-			ImageObject: fileName,
+		if fileName, ok := img["file_name"].(string); ok && fileName != "" {
+			width, _ := img["width"].(int)
+			height, _ := img["height"].(int)
+			contentHash, _ := img["content_hash"].(string)
+			var perceptualHash *uint64
+			if hash, ok := img["perceptual_hash"].(uint64); ok {
+				perceptualHash = &hash
+			}
+			uploads = append(uploads, model.UploadedImage{
+				ObjectKey:      fileName,
+				Width:          width,
+				Height:         height,
+				ContentHash:    contentHash,
+				PerceptualHash: perceptualHash,
+			})
 		}
+	}
 
-		if err := uc.ProductRepository.CreateImage(tx, &productImage); err != nil {
-			uc.Log.WithError(err).Error("Failed to create product image record")
-			tx.Rollback()
-			return nil, utils.WrapMessageAsError(constants.FailedCreateProduct, err)
-		}
+	_, warnings, err := createProductImages(tx, uc.ProductRepository, uc.ProductImageRepository, product, uploads)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to create product image record")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateProduct, err)
 	}
 
 	if err := tx.Commit().Error; err != nil {
@@ -263,6 +1146,7 @@ func (uc *ProductUseCase) UploadProductImages(ctx context.Context, productID uui
 	return &model.UploadFilesResponse{
 		ProductID: productID,
 		Images:    extractImageURLs(images),
+		Warnings:  warnings,
 	}, nil
 }
 
@@ -276,41 +1160,181 @@ func extractImageURLs(images []map[string]any) []string {
 	return urls
 }
 
-func (uc *ProductUseCase) DecreaseProductQuantity(ctx context.Context, productID uuid.UUID, quantity int) (int32, error) {
+func (uc *ProductUseCase) DecreaseProductQuantity(ctx context.Context, productID uuid.UUID, quantity int, userID uuid.UUID, customerGroup string) (*model.DecreaseQuantityResult, error) {
+	if quantity <= 0 {
+		return nil, utils.WrapMessageAsError(constants.InvalidDecreaseQuantity)
+	}
+
 	tx := uc.DB.WithContext(ctx).Begin()
 	defer tx.Rollback()
 
 	product, err := uc.ProductRepository.FindProductById(tx, productID)
 	if err != nil {
 		uc.Log.WithError(err).Error("Failed to find product by ID")
-		return 0, utils.WrapMessageAsError(constants.FailedGetProductByID)
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID)
 	}
 
-	if product == nil {
-		return 0, utils.WrapMessageAsError(constants.ProductNotFound)
+	if product == nil || product.Status != model.ProductStatusPublished {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	var components []entity.BundleComponent
+	var stock map[uuid.UUID]int
+	if product.Type == model.ProductTypeBundle {
+		components, err = uc.BundleComponentRepository.FindByBundleIds(tx, []uuid.UUID{product.ID})
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to find bundle components")
+			return nil, utils.WrapMessageAsError(constants.FailedDecreaseProductQuantity, err)
+		}
+
+		stock, err = findComponentStock(tx, bundleComponentIDs(components), true)
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to lock bundle component stock")
+			return nil, utils.WrapMessageAsError(constants.FailedDecreaseProductQuantity, err)
+		}
+
+		product.Quantity = deriveBundleQuantity(components, stock)
 	}
 
 	if product.Quantity < quantity {
-		return 0, utils.WrapMessageAsError(constants.InsufficientProductQuantity)
+		return nil, utils.WrapMessageAsError(constants.InsufficientProductQuantity)
 	}
 
-	product.Quantity -= quantity
-	product.UpdatedAt = time.Now()
+	now := time.Now()
+	if err := resolveEffectivePrices(tx, uc.CategoryRepository, uc.PriceCampaignRepository, []*entity.Product{product}, now); err != nil {
+		uc.Log.WithError(err).Error("Failed to resolve effective price")
+		return nil, utils.WrapMessageAsError(constants.FailedResolveEffectivePrice, err)
+	}
+
+	quote, err := quoteProductPrice(tx, uc.PriceListRepository, uc.PriceTierRepository, product, quantity, customerGroup)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to quote product price")
+		return nil, utils.WrapMessageAsError(constants.FailedQuotePrice, err)
+	}
+
+	result := &model.DecreaseQuantityResult{UnitPrice: quote.UnitPrice, Currency: quote.Currency}
+
+	sale, err := uc.claimFlashSale(ctx, tx, product, quote.UnitPrice, userID, quantity, now)
+	if err != nil {
+		uc.Log.WithError(err).Warnf("Failed to claim flash sale quota for product %s", product.ID)
+	}
 
-	if err := tx.Save(product).Error; err != nil {
-		uc.Log.WithError(err).Error("Failed to decrease product quantity")
-		return 0, utils.WrapMessageAsError(constants.FailedDecreaseProductQuantity, err)
+	committed := false
+	if sale != nil {
+		defer func() {
+			if committed {
+				return
+			}
+			if err := uc.FlashSaleQuotaRepository.Release(ctx, sale.ID, userID, quantity); err != nil {
+				uc.Log.WithError(err).Errorf("Failed to release flash sale %s quota", sale.ID)
+			}
+		}()
+
+		if err := uc.FlashSaleRepository.IncrementSold(tx, sale.ID, quantity); err != nil {
+			uc.Log.WithError(err).Error("Failed to record flash sale units")
+			return nil, utils.WrapMessageAsError(constants.FailedDecreaseProductQuantity, err)
+		}
+
+		result.UnitPrice = sale.SalePrice
+		result.FlashSaleID = &sale.ID
+	}
+
+	stockIDs := []uuid.UUID{product.ID}
+	if product.Type == model.ProductTypeBundle {
+		for _, component := range components {
+			required := component.Quantity * quantity
+			decrement := tx.Model(&entity.Product{}).
+				Where("id = ? AND quantity >= ?", component.ComponentID, required).
+				UpdateColumns(map[string]any{
+					"quantity":   gorm.Expr("quantity - ?", required),
+					"updated_at": now,
+				})
+			if decrement.Error != nil {
+				uc.Log.WithError(decrement.Error).Error("Failed to decrease bundle component quantity")
+				return nil, utils.WrapMessageAsError(constants.FailedDecreaseProductQuantity, decrement.Error)
+			}
+			if decrement.RowsAffected == 0 {
+				return nil, utils.WrapMessageAsError(constants.InsufficientProductQuantity)
+			}
+			stock[component.ComponentID] -= required
+		}
+
+		stockIDs = bundleComponentIDs(components)
+		product.Quantity = deriveBundleQuantity(components, stock)
+	} else {
+		decrement := tx.Model(&entity.Product{}).
+			Where("id = ? AND quantity >= ?", product.ID, quantity).
+			UpdateColumns(map[string]any{
+				"quantity":   gorm.Expr("quantity - ?", quantity),
+				"updated_at": now,
+			})
+		if decrement.Error != nil {
+			uc.Log.WithError(decrement.Error).Error("Failed to decrease product quantity")
+			return nil, utils.WrapMessageAsError(constants.FailedDecreaseProductQuantity, decrement.Error)
+		}
+		if decrement.RowsAffected == 0 {
+			return nil, utils.WrapMessageAsError(constants.InsufficientProductQuantity)
+		}
+
+		stock, err = findComponentStock(tx, stockIDs, false)
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to read decreased product quantity")
+			return nil, utils.WrapMessageAsError(constants.FailedDecreaseProductQuantity, err)
+		}
+		product.Quantity = stock[product.ID]
+	}
+	product.UpdatedAt = now
+
+	bundles, err := refreshBundleStock(tx, uc.ProductRepository, uc.BundleComponentRepository, stockIDs)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to sync bundle stock")
+		return nil, utils.WrapMessageAsError(constants.FailedSyncBundleStock, err)
 	}
 
 	if err := tx.Commit().Error; err != nil {
 		uc.Log.WithError(err).Error("Failed to commit transaction for decreasing product quantity")
-		return 0, utils.WrapMessageAsError(constants.FailedDecreaseProductQuantity, err)
+		return nil, utils.WrapMessageAsError(constants.FailedDecreaseProductQuantity, err)
 	}
+	committed = true
+	result.NewQuantity = int32(product.Quantity)
 
 	if err := uc.ElasticsearchUseCase.InsertDocument(product.ID, product); err != nil {
 		uc.Log.WithError(err).Error("Failed to update product in Elasticsearch after decreasing quantity")
-		return 0, utils.WrapMessageAsError(constants.FailedUpdateProductInElasticsearch, err)
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductInElasticsearch, err)
+	}
+
+	if len(components) > 0 {
+		componentProducts, err := uc.ProductRepository.FindProductsByIds(uc.DB.WithContext(ctx), stockIDs)
+		if err != nil {
+			uc.Log.WithError(err).Warn("Failed to load bundle components for reindexing")
+		}
+		for i := range componentProducts {
+			bundles = append(bundles, &componentProducts[i])
+		}
+	}
+	uc.reindexProducts(bundles)
+
+	return result, nil
+}
+
+func (uc *ProductUseCase) claimFlashSale(ctx context.Context, tx *gorm.DB, product *entity.Product, unitPrice int64, userID uuid.UUID, quantity int, now time.Time) (*entity.FlashSale, error) {
+	sale, err := uc.FlashSaleRepository.FindLiveByProductId(tx, product.ID, now)
+	if err != nil || sale == nil {
+		return nil, err
+	}
+
+	if sale.SalePrice >= unitPrice || (sale.PerUserLimit > 0 && userID == uuid.Nil) {
+		return nil, nil
+	}
+
+	remaining, err := uc.FlashSaleQuotaRepository.Claim(ctx, sale.ID, userID, quantity, sale.PerUserLimit, sale.Quota-sale.Sold, flashSaleTTL(sale))
+	if err != nil {
+		return nil, err
+	}
+
+	if remaining == model.FlashSaleClaimSoldOut || remaining == model.FlashSaleClaimLimitReached {
+		return nil, nil
 	}
 
-	return int32(product.Quantity), nil
+	return sale, nil
 }
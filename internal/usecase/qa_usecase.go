@@ -0,0 +1,488 @@
+package usecase
+
+import (
+	"context"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type QAUseCase struct {
+	DB                        *gorm.DB
+	Log                       *logrus.Logger
+	Validate                  *validator.Validate
+	ProductRepository         *repository.ProductRepository
+	ProductQuestionRepository *repository.ProductQuestionRepository
+	ProductAnswerRepository   *repository.ProductAnswerRepository
+	ProductQAVoteRepository   *repository.ProductQAVoteRepository
+	PurchaseVerifier          repository.PurchaseVerifier
+}
+
+func NewQAUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, productRepository *repository.ProductRepository, productQuestionRepository *repository.ProductQuestionRepository, productAnswerRepository *repository.ProductAnswerRepository, productQAVoteRepository *repository.ProductQAVoteRepository, purchaseVerifier repository.PurchaseVerifier) *QAUseCase {
+	return &QAUseCase{
+		DB:                        db,
+		Log:                       log,
+		Validate:                  validate,
+		ProductRepository:         productRepository,
+		ProductQuestionRepository: productQuestionRepository,
+		ProductAnswerRepository:   productAnswerRepository,
+		ProductQAVoteRepository:   productQAVoteRepository,
+		PurchaseVerifier:          purchaseVerifier,
+	}
+}
+
+func (uc *QAUseCase) GetProductQuestions(ctx context.Context, productID uuid.UUID, sort string, limit, offset int) ([]*model.QuestionResponse, int64, error) {
+	db := uc.DB.WithContext(ctx)
+
+	if _, err := findPublishedProduct(db, uc.Log, uc.ProductRepository, productID); err != nil {
+		return nil, 0, err
+	}
+
+	questions, total, err := uc.ProductQuestionRepository.FindByProductId(db, productID, sort, limit, offset)
+	if err != nil {
+		return nil, 0, utils.WrapMessageAsError(constants.FailedGetQuestions, err)
+	}
+
+	return converter.ToQuestionResponses(questions), total, nil
+}
+
+func (uc *QAUseCase) GetProductQuestion(ctx context.Context, productID uuid.UUID, questionID uuid.UUID) (*model.QuestionResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	if _, err := findPublishedProduct(db, uc.Log, uc.ProductRepository, productID); err != nil {
+		return nil, err
+	}
+
+	question, err := uc.ProductQuestionRepository.FindApprovedById(db, productID, questionID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find question by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetQuestionByID, err)
+	}
+
+	if question == nil {
+		return nil, utils.WrapMessageAsError(constants.QuestionNotFound)
+	}
+
+	return converter.ToQuestionResponse(question), nil
+}
+
+func (uc *QAUseCase) GetQuestions(ctx context.Context, status string, limit, offset int) ([]*model.QuestionResponse, int64, error) {
+	questions, total, err := uc.ProductQuestionRepository.FindByStatus(uc.DB.WithContext(ctx), status, limit, offset)
+	if err != nil {
+		return nil, 0, utils.WrapMessageAsError(constants.FailedGetQuestions, err)
+	}
+
+	return converter.ToQuestionResponses(questions), total, nil
+}
+
+func (uc *QAUseCase) GetAnswers(ctx context.Context, status string, limit, offset int) ([]*model.AnswerResponse, int64, error) {
+	answers, total, err := uc.ProductAnswerRepository.FindByStatus(uc.DB.WithContext(ctx), status, limit, offset)
+	if err != nil {
+		return nil, 0, utils.WrapMessageAsError(constants.FailedGetAnswers, err)
+	}
+
+	return converter.ToAnswerResponses(answers), total, nil
+}
+
+func (uc *QAUseCase) CreateQuestion(ctx context.Context, productID uuid.UUID, request *model.QuestionRequest, userID uuid.UUID) (*model.QuestionResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	if _, err := findPublishedProduct(tx, uc.Log, uc.ProductRepository, productID); err != nil {
+		return nil, err
+	}
+
+	question := &entity.ProductQuestion{
+		ID:        uuid.New(),
+		ProductID: productID,
+		UserID:    userID,
+		Body:      request.Body,
+		Status:    model.QAStatusPending,
+	}
+
+	if err := uc.ProductQuestionRepository.Create(tx.Omit("Product"), question); err != nil {
+		uc.Log.WithError(err).Error("Failed to create question")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateQuestion, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for question creation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateQuestion, err)
+	}
+
+	return converter.ToQuestionResponse(question), nil
+}
+
+func (uc *QAUseCase) DeleteQuestion(ctx context.Context, productID uuid.UUID, questionID uuid.UUID, userID uuid.UUID, isAdmin bool) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	question, err := uc.ProductQuestionRepository.FindQuestionById(tx, questionID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find question by ID")
+		return utils.WrapMessageAsError(constants.FailedDeleteQuestion, err)
+	}
+
+	if question == nil || question.ProductID != productID || (!isAdmin && question.UserID != userID) {
+		return utils.WrapMessageAsError(constants.QuestionNotFound)
+	}
+
+	answerIDs, err := uc.ProductAnswerRepository.FindIdsByQuestionId(tx, questionID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find answers by question ID")
+		return utils.WrapMessageAsError(constants.FailedDeleteQuestion, err)
+	}
+
+	if err := uc.ProductQAVoteRepository.DeleteByTargets(tx, model.QATargetAnswer, answerIDs); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete answer votes")
+		return utils.WrapMessageAsError(constants.FailedDeleteQuestion, err)
+	}
+
+	if err := uc.ProductQAVoteRepository.DeleteByTargets(tx, model.QATargetQuestion, []uuid.UUID{questionID}); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete question votes")
+		return utils.WrapMessageAsError(constants.FailedDeleteQuestion, err)
+	}
+
+	if err := uc.ProductQuestionRepository.Delete(tx, question); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete question")
+		return utils.WrapMessageAsError(constants.FailedDeleteQuestion, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for question deletion")
+		return utils.WrapMessageAsError(constants.FailedDeleteQuestion, err)
+	}
+
+	return nil
+}
+
+func (uc *QAUseCase) CreateAnswer(ctx context.Context, productID uuid.UUID, questionID uuid.UUID, request *model.AnswerRequest, userID uuid.UUID, isAdmin bool) (*model.AnswerResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	question, err := uc.ProductQuestionRepository.FindQuestionById(tx, questionID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find question by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateAnswer, err)
+	}
+
+	if question == nil || question.ProductID != productID || question.Status != model.QAStatusApproved {
+		return nil, utils.WrapMessageAsError(constants.QuestionNotFound)
+	}
+
+	purchased, err := uc.PurchaseVerifier.HasPurchased(ctx, userID, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to verify purchase")
+		return nil, utils.WrapMessageAsError(constants.FailedVerifyPurchase, err)
+	}
+
+	if !isAdmin && !purchased {
+		return nil, utils.WrapMessageAsError(constants.AnswerBuyerRequired)
+	}
+
+	answer := &entity.ProductAnswer{
+		ID:              uuid.New(),
+		QuestionID:      questionID,
+		UserID:          userID,
+		Body:            request.Body,
+		IsOfficial:      isAdmin,
+		IsVerifiedBuyer: purchased,
+		Status:          model.QAStatusPending,
+	}
+
+	if isAdmin {
+		now := time.Now()
+		answer.Status = model.QAStatusApproved
+		answer.ModeratedBy = &userID
+		answer.ModeratedAt = &now
+	}
+
+	if err := uc.ProductAnswerRepository.Create(tx, answer); err != nil {
+		uc.Log.WithError(err).Error("Failed to create answer")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateAnswer, err)
+	}
+
+	if err := uc.ProductQuestionRepository.RefreshAnswerCount(tx, questionID); err != nil {
+		uc.Log.WithError(err).Error("Failed to refresh answer count")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateAnswer, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for answer creation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateAnswer, err)
+	}
+
+	return converter.ToAnswerResponse(answer), nil
+}
+
+func (uc *QAUseCase) DeleteAnswer(ctx context.Context, productID uuid.UUID, questionID uuid.UUID, answerID uuid.UUID, userID uuid.UUID, isAdmin bool) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	question, err := uc.ProductQuestionRepository.FindQuestionById(tx, questionID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find question by ID")
+		return utils.WrapMessageAsError(constants.FailedDeleteAnswer, err)
+	}
+
+	if question == nil || question.ProductID != productID {
+		return utils.WrapMessageAsError(constants.QuestionNotFound)
+	}
+
+	answer, err := uc.ProductAnswerRepository.FindAnswerById(tx, answerID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find answer by ID")
+		return utils.WrapMessageAsError(constants.FailedDeleteAnswer, err)
+	}
+
+	if answer == nil || answer.QuestionID != questionID || (!isAdmin && answer.UserID != userID) {
+		return utils.WrapMessageAsError(constants.AnswerNotFound)
+	}
+
+	if err := uc.ProductQAVoteRepository.DeleteByTargets(tx, model.QATargetAnswer, []uuid.UUID{answerID}); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete answer votes")
+		return utils.WrapMessageAsError(constants.FailedDeleteAnswer, err)
+	}
+
+	if err := uc.ProductAnswerRepository.Delete(tx, answer); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete answer")
+		return utils.WrapMessageAsError(constants.FailedDeleteAnswer, err)
+	}
+
+	if err := uc.ProductQuestionRepository.RefreshAnswerCount(tx, questionID); err != nil {
+		uc.Log.WithError(err).Error("Failed to refresh answer count")
+		return utils.WrapMessageAsError(constants.FailedDeleteAnswer, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for answer deletion")
+		return utils.WrapMessageAsError(constants.FailedDeleteAnswer, err)
+	}
+
+	return nil
+}
+
+func (uc *QAUseCase) ModerateQuestion(ctx context.Context, questionID uuid.UUID, request *model.QAModerationRequest, actorID uuid.UUID) (*model.QuestionResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	question, err := uc.ProductQuestionRepository.FindQuestionById(tx, questionID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find question by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedModerateQuestion, err)
+	}
+
+	if question == nil {
+		return nil, utils.WrapMessageAsError(constants.QuestionNotFound)
+	}
+
+	now := time.Now()
+	question.Status = request.Status
+	question.ModerationNote = request.Note
+	question.ModeratedBy = &actorID
+	question.ModeratedAt = &now
+
+	if err := uc.ProductQuestionRepository.Update(tx.Omit("Product", "Answers"), question); err != nil {
+		uc.Log.WithError(err).Error("Failed to moderate question")
+		return nil, utils.WrapMessageAsError(constants.FailedModerateQuestion, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for question moderation")
+		return nil, utils.WrapMessageAsError(constants.FailedModerateQuestion, err)
+	}
+
+	return converter.ToQuestionResponse(question), nil
+}
+
+func (uc *QAUseCase) ModerateAnswer(ctx context.Context, answerID uuid.UUID, request *model.QAModerationRequest, actorID uuid.UUID) (*model.AnswerResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	answer, err := uc.ProductAnswerRepository.FindAnswerById(tx, answerID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find answer by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedModerateAnswer, err)
+	}
+
+	if answer == nil {
+		return nil, utils.WrapMessageAsError(constants.AnswerNotFound)
+	}
+
+	now := time.Now()
+	answer.Status = request.Status
+	answer.ModerationNote = request.Note
+	answer.ModeratedBy = &actorID
+	answer.ModeratedAt = &now
+
+	if err := uc.ProductAnswerRepository.Update(tx, answer); err != nil {
+		uc.Log.WithError(err).Error("Failed to moderate answer")
+		return nil, utils.WrapMessageAsError(constants.FailedModerateAnswer, err)
+	}
+
+	if err := uc.ProductQuestionRepository.RefreshAnswerCount(tx, answer.QuestionID); err != nil {
+		uc.Log.WithError(err).Error("Failed to refresh answer count")
+		return nil, utils.WrapMessageAsError(constants.FailedModerateAnswer, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for answer moderation")
+		return nil, utils.WrapMessageAsError(constants.FailedModerateAnswer, err)
+	}
+
+	return converter.ToAnswerResponse(answer), nil
+}
+
+func (uc *QAUseCase) Upvote(ctx context.Context, productID uuid.UUID, targetType string, targetID uuid.UUID, userID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	authorID, err := uc.findVoteTarget(tx, productID, targetType, targetID)
+	if err != nil {
+		return err
+	}
+
+	if authorID == userID {
+		return utils.WrapMessageAsError(constants.UpvoteOwn)
+	}
+
+	vote, err := uc.ProductQAVoteRepository.FindVote(tx, targetType, targetID, userID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find upvote")
+		return utils.WrapMessageAsError(constants.FailedUpvote, err)
+	}
+
+	if vote != nil {
+		return utils.WrapMessageAsError(constants.UpvoteExists)
+	}
+
+	if err := uc.ProductQAVoteRepository.Create(tx, &entity.ProductQAVote{
+		ID:         uuid.New(),
+		TargetType: targetType,
+		TargetID:   targetID,
+		UserID:     userID,
+	}); err != nil {
+		uc.Log.WithError(err).Error("Failed to create upvote")
+		return utils.WrapMessageAsError(constants.FailedUpvote, err)
+	}
+
+	if err := uc.updateUpvoteCount(tx, targetType, targetID, 1); err != nil {
+		uc.Log.WithError(err).Error("Failed to update upvote count")
+		return utils.WrapMessageAsError(constants.FailedUpvote, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for upvote")
+		return utils.WrapMessageAsError(constants.FailedUpvote, err)
+	}
+
+	return nil
+}
+
+func (uc *QAUseCase) RemoveUpvote(ctx context.Context, productID uuid.UUID, targetType string, targetID uuid.UUID, userID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if _, err := uc.findVoteTarget(tx, productID, targetType, targetID); err != nil {
+		return err
+	}
+
+	vote, err := uc.ProductQAVoteRepository.FindVote(tx, targetType, targetID, userID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find upvote")
+		return utils.WrapMessageAsError(constants.FailedRemoveUpvote, err)
+	}
+
+	if vote == nil {
+		return utils.WrapMessageAsError(constants.UpvoteNotFound)
+	}
+
+	if err := uc.ProductQAVoteRepository.Delete(tx, vote); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete upvote")
+		return utils.WrapMessageAsError(constants.FailedRemoveUpvote, err)
+	}
+
+	if err := uc.updateUpvoteCount(tx, targetType, targetID, -1); err != nil {
+		uc.Log.WithError(err).Error("Failed to update upvote count")
+		return utils.WrapMessageAsError(constants.FailedRemoveUpvote, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for upvote removal")
+		return utils.WrapMessageAsError(constants.FailedRemoveUpvote, err)
+	}
+
+	return nil
+}
+
+func (uc *QAUseCase) findVoteTarget(tx *gorm.DB, productID uuid.UUID, targetType string, targetID uuid.UUID) (uuid.UUID, error) {
+	questionID := targetID
+	var authorID uuid.UUID
+
+	if targetType == model.QATargetAnswer {
+		answer, err := uc.ProductAnswerRepository.FindAnswerById(tx, targetID)
+		if err != nil {
+			uc.Log.WithError(err).Error("Failed to find answer by ID")
+			return uuid.Nil, utils.WrapMessageAsError(constants.FailedUpvote, err)
+		}
+
+		if answer == nil || answer.Status != model.QAStatusApproved {
+			return uuid.Nil, utils.WrapMessageAsError(constants.AnswerNotFound)
+		}
+
+		questionID = answer.QuestionID
+		authorID = answer.UserID
+	}
+
+	question, err := uc.ProductQuestionRepository.FindQuestionById(tx, questionID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find question by ID")
+		return uuid.Nil, utils.WrapMessageAsError(constants.FailedUpvote, err)
+	}
+
+	if question == nil || question.ProductID != productID || question.Status != model.QAStatusApproved {
+		return uuid.Nil, utils.WrapMessageAsError(constants.QuestionNotFound)
+	}
+
+	if targetType == model.QATargetQuestion {
+		authorID = question.UserID
+	}
+
+	return authorID, nil
+}
+
+func (uc *QAUseCase) updateUpvoteCount(tx *gorm.DB, targetType string, targetID uuid.UUID, delta int) error {
+	if targetType == model.QATargetAnswer {
+		return uc.ProductAnswerRepository.UpdateUpvoteCount(tx, targetID, delta)
+	}
+	return uc.ProductQuestionRepository.UpdateUpvoteCount(tx, targetID, delta)
+}
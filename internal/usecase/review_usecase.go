@@ -0,0 +1,550 @@
+package usecase
+
+import (
+	"context"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"golectro-product/internal/model/converter"
+	"golectro-product/internal/repository"
+	"golectro-product/internal/utils"
+	"math"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+type ReviewUseCase struct {
+	DB                          *gorm.DB
+	Log                         *logrus.Logger
+	Validate                    *validator.Validate
+	Viper                       *viper.Viper
+	ProductRepository           *repository.ProductRepository
+	ProductReviewRepository     *repository.ProductReviewRepository
+	ProductReviewVoteRepository *repository.ProductReviewVoteRepository
+	PurchaseVerifier            repository.PurchaseVerifier
+	MinioUseCase                *MinioUseCase
+	ElasticsearchUseCase        *ElasticsearchUseCase
+}
+
+func NewReviewUsecase(db *gorm.DB, log *logrus.Logger, validate *validator.Validate, viper *viper.Viper, productRepository *repository.ProductRepository, productReviewRepository *repository.ProductReviewRepository, productReviewVoteRepository *repository.ProductReviewVoteRepository, purchaseVerifier repository.PurchaseVerifier, minioUseCase *MinioUseCase, elasticsearchUseCase *ElasticsearchUseCase) *ReviewUseCase {
+	return &ReviewUseCase{
+		DB:                          db,
+		Log:                         log,
+		Validate:                    validate,
+		Viper:                       viper,
+		ProductRepository:           productRepository,
+		ProductReviewRepository:     productReviewRepository,
+		ProductReviewVoteRepository: productReviewVoteRepository,
+		PurchaseVerifier:            purchaseVerifier,
+		MinioUseCase:                minioUseCase,
+		ElasticsearchUseCase:        elasticsearchUseCase,
+	}
+}
+
+func (uc *ReviewUseCase) GetProductReviews(ctx context.Context, productID uuid.UUID, sort string, limit, offset int) ([]*model.ReviewResponse, int64, error) {
+	db := uc.DB.WithContext(ctx)
+
+	if _, err := findPublishedProduct(db, uc.Log, uc.ProductRepository, productID); err != nil {
+		return nil, 0, err
+	}
+
+	reviews, total, err := uc.ProductReviewRepository.FindByProductId(db, productID, model.ReviewStatusApproved, sort, limit, offset)
+	if err != nil {
+		return nil, 0, utils.WrapMessageAsError(constants.FailedGetReviews, err)
+	}
+
+	return uc.toReviewResponses(ctx, reviews), total, nil
+}
+
+func (uc *ReviewUseCase) GetReviews(ctx context.Context, status string, limit, offset int) ([]*model.ReviewResponse, int64, error) {
+	reviews, total, err := uc.ProductReviewRepository.FindByStatus(uc.DB.WithContext(ctx), status, limit, offset)
+	if err != nil {
+		return nil, 0, utils.WrapMessageAsError(constants.FailedGetReviews, err)
+	}
+
+	return uc.toReviewResponses(ctx, reviews), total, nil
+}
+
+func (uc *ReviewUseCase) GetRatingSummary(ctx context.Context, productID uuid.UUID) (*model.RatingSummaryResponse, error) {
+	db := uc.DB.WithContext(ctx)
+
+	if _, err := findPublishedProduct(db, uc.Log, uc.ProductRepository, productID); err != nil {
+		return nil, err
+	}
+
+	distribution, err := uc.ProductReviewRepository.CountRatings(db, productID)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedGetRatingSummary, err)
+	}
+
+	average, count := summarizeRatings(distribution)
+
+	return &model.RatingSummaryResponse{
+		ProductID:     productID,
+		RatingAverage: average,
+		RatingCount:   count,
+		Distribution:  distribution,
+	}, nil
+}
+
+func (uc *ReviewUseCase) CreateReview(ctx context.Context, productID uuid.UUID, request *model.ReviewRequest, userID uuid.UUID) (*model.ReviewResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	if _, err := findPublishedProduct(tx, uc.Log, uc.ProductRepository, productID); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.ProductReviewRepository.FindByProductAndUser(tx, productID, userID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find existing review")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateReview, err)
+	}
+
+	if existing != nil {
+		return nil, utils.WrapMessageAsError(constants.ReviewAlreadyExists)
+	}
+
+	purchased, err := uc.PurchaseVerifier.HasPurchased(ctx, userID, productID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to verify purchase")
+		return nil, utils.WrapMessageAsError(constants.FailedVerifyPurchase, err)
+	}
+
+	if !purchased {
+		return nil, utils.WrapMessageAsError(constants.ReviewPurchaseRequired)
+	}
+
+	review := &entity.ProductReview{
+		ID:        uuid.New(),
+		ProductID: productID,
+		UserID:    userID,
+		Rating:    request.Rating,
+		Title:     request.Title,
+		Body:      request.Body,
+		Status:    model.ReviewStatusPending,
+	}
+
+	if err := uc.ProductReviewRepository.Create(tx.Omit("Product"), review); err != nil {
+		uc.Log.WithError(err).Error("Failed to create review")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateReview, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for review creation")
+		return nil, utils.WrapMessageAsError(constants.FailedCreateReview, err)
+	}
+
+	return converter.ToReviewResponse(review), nil
+}
+
+func (uc *ReviewUseCase) UpdateReview(ctx context.Context, productID uuid.UUID, reviewID uuid.UUID, request *model.ReviewRequest, userID uuid.UUID) (*model.ReviewResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	review, err := uc.findOwnReview(tx, productID, reviewID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	wasApproved := review.Status == model.ReviewStatusApproved
+	review.Rating = request.Rating
+	review.Title = request.Title
+	review.Body = request.Body
+	resetModeration(review)
+
+	if err := uc.ProductReviewRepository.Update(tx.Omit("Product", "Photos"), review); err != nil {
+		uc.Log.WithError(err).Error("Failed to update review")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateReview, err)
+	}
+
+	var product *entity.Product
+	if wasApproved {
+		if product, err = uc.refreshProductRating(tx, productID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for review update")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateReview, err)
+	}
+
+	uc.reindexProduct(product)
+
+	return uc.toReviewResponse(ctx, review), nil
+}
+
+func (uc *ReviewUseCase) DeleteReview(ctx context.Context, productID uuid.UUID, reviewID uuid.UUID, userID uuid.UUID, isAdmin bool) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	review, err := uc.ProductReviewRepository.FindReviewById(tx, reviewID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find review by ID")
+		return utils.WrapMessageAsError(constants.FailedDeleteReview, err)
+	}
+
+	if review == nil || review.ProductID != productID || (!isAdmin && review.UserID != userID) {
+		return utils.WrapMessageAsError(constants.ReviewNotFound)
+	}
+
+	if err := uc.ProductReviewRepository.Delete(tx, review); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete review")
+		return utils.WrapMessageAsError(constants.FailedDeleteReview, err)
+	}
+
+	var product *entity.Product
+	if review.Status == model.ReviewStatusApproved {
+		if product, err = uc.refreshProductRating(tx, productID); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for review deletion")
+		return utils.WrapMessageAsError(constants.FailedDeleteReview, err)
+	}
+
+	for _, photo := range review.Photos {
+		uc.deletePhotoObject(ctx, photo.PhotoObject)
+	}
+	uc.reindexProduct(product)
+
+	return nil
+}
+
+func (uc *ReviewUseCase) UploadReviewPhotos(ctx context.Context, productID uuid.UUID, reviewID uuid.UUID, userID uuid.UUID, files []map[string]any) (*model.ReviewResponse, error) {
+	response, err := uc.addReviewPhotos(ctx, productID, reviewID, userID, files)
+	if err != nil {
+		for _, file := range files {
+			if fileName, ok := file["file_name"].(string); ok && fileName != "" {
+				uc.deletePhotoObject(ctx, fileName)
+			}
+		}
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (uc *ReviewUseCase) addReviewPhotos(ctx context.Context, productID uuid.UUID, reviewID uuid.UUID, userID uuid.UUID, files []map[string]any) (*model.ReviewResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	review, err := uc.findOwnReview(tx, productID, reviewID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	photos := make([]entity.ProductReviewPhoto, 0, len(files))
+	for _, file := range files {
+		fileName, ok := file["file_name"].(string)
+		if !ok || fileName == "" {
+			continue
+		}
+		photos = append(photos, entity.ProductReviewPhoto{
+			ID:          uuid.New(),
+			ReviewID:    review.ID,
+			PhotoObject: fileName,
+		})
+	}
+
+	if len(review.Photos)+len(photos) > model.ReviewMaxPhotos {
+		return nil, utils.WrapMessageAsError(constants.ReviewPhotoLimitExceeded)
+	}
+
+	if len(photos) > 0 {
+		if err := uc.ProductReviewRepository.CreatePhotos(tx, photos); err != nil {
+			uc.Log.WithError(err).Error("Failed to create review photos")
+			return nil, utils.WrapMessageAsError(constants.FailedUploadReviewPhotos, err)
+		}
+	}
+
+	wasApproved := review.Status == model.ReviewStatusApproved
+	review.Photos = append(review.Photos, photos...)
+	resetModeration(review)
+
+	if err := uc.ProductReviewRepository.Update(tx.Omit("Product", "Photos"), review); err != nil {
+		uc.Log.WithError(err).Error("Failed to update review")
+		return nil, utils.WrapMessageAsError(constants.FailedUploadReviewPhotos, err)
+	}
+
+	var product *entity.Product
+	if wasApproved {
+		if product, err = uc.refreshProductRating(tx, productID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for review photo upload")
+		return nil, utils.WrapMessageAsError(constants.FailedUploadReviewPhotos, err)
+	}
+
+	uc.reindexProduct(product)
+
+	return uc.toReviewResponse(ctx, review), nil
+}
+
+func (uc *ReviewUseCase) ModerateReview(ctx context.Context, reviewID uuid.UUID, request *model.ReviewModerationRequest, actorID uuid.UUID) (*model.ReviewResponse, error) {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	if err := uc.Validate.Struct(request); err != nil {
+		message := utils.TranslateValidationError(uc.Validate, err)
+		return nil, utils.WrapMessageAsError(message)
+	}
+
+	review, err := uc.ProductReviewRepository.FindReviewById(tx, reviewID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find review by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedModerateReview, err)
+	}
+
+	if review == nil {
+		return nil, utils.WrapMessageAsError(constants.ReviewNotFound)
+	}
+
+	now := time.Now()
+	ratingChanged := review.Status == model.ReviewStatusApproved || request.Status == model.ReviewStatusApproved
+	review.Status = request.Status
+	review.ModerationNote = request.Note
+	review.ModeratedBy = &actorID
+	review.ModeratedAt = &now
+
+	if err := uc.ProductReviewRepository.Update(tx.Omit("Product", "Photos"), review); err != nil {
+		uc.Log.WithError(err).Error("Failed to moderate review")
+		return nil, utils.WrapMessageAsError(constants.FailedModerateReview, err)
+	}
+
+	var product *entity.Product
+	if ratingChanged {
+		if product, err = uc.refreshProductRating(tx, review.ProductID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for review moderation")
+		return nil, utils.WrapMessageAsError(constants.FailedModerateReview, err)
+	}
+
+	uc.reindexProduct(product)
+
+	return uc.toReviewResponse(ctx, review), nil
+}
+
+func (uc *ReviewUseCase) VoteHelpful(ctx context.Context, reviewID uuid.UUID, userID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	review, err := uc.ProductReviewRepository.FindReviewById(tx, reviewID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find review by ID")
+		return utils.WrapMessageAsError(constants.FailedVoteReview, err)
+	}
+
+	if review == nil {
+		return utils.WrapMessageAsError(constants.ReviewNotFound)
+	}
+
+	if review.Status != model.ReviewStatusApproved {
+		return utils.WrapMessageAsError(constants.ReviewNotApproved)
+	}
+
+	if review.UserID == userID {
+		return utils.WrapMessageAsError(constants.ReviewVoteOwn)
+	}
+
+	vote, err := uc.ProductReviewVoteRepository.FindVote(tx, reviewID, userID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find review vote")
+		return utils.WrapMessageAsError(constants.FailedVoteReview, err)
+	}
+
+	if vote != nil {
+		return utils.WrapMessageAsError(constants.ReviewVoteExists)
+	}
+
+	if err := uc.ProductReviewVoteRepository.Create(tx.Omit("Review"), &entity.ProductReviewVote{
+		ID:       uuid.New(),
+		ReviewID: reviewID,
+		UserID:   userID,
+	}); err != nil {
+		uc.Log.WithError(err).Error("Failed to create review vote")
+		return utils.WrapMessageAsError(constants.FailedVoteReview, err)
+	}
+
+	if err := uc.ProductReviewRepository.UpdateHelpfulCount(tx, reviewID, 1); err != nil {
+		uc.Log.WithError(err).Error("Failed to update review helpful count")
+		return utils.WrapMessageAsError(constants.FailedVoteReview, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for review vote")
+		return utils.WrapMessageAsError(constants.FailedVoteReview, err)
+	}
+
+	return nil
+}
+
+func (uc *ReviewUseCase) RemoveHelpfulVote(ctx context.Context, reviewID uuid.UUID, userID uuid.UUID) error {
+	tx := uc.DB.WithContext(ctx).Begin()
+	defer tx.Rollback()
+
+	vote, err := uc.ProductReviewVoteRepository.FindVote(tx, reviewID, userID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find review vote")
+		return utils.WrapMessageAsError(constants.FailedRemoveReviewVote, err)
+	}
+
+	if vote == nil {
+		return utils.WrapMessageAsError(constants.ReviewVoteNotFound)
+	}
+
+	if err := uc.ProductReviewVoteRepository.Delete(tx, vote); err != nil {
+		uc.Log.WithError(err).Error("Failed to delete review vote")
+		return utils.WrapMessageAsError(constants.FailedRemoveReviewVote, err)
+	}
+
+	if err := uc.ProductReviewRepository.UpdateHelpfulCount(tx, reviewID, -1); err != nil {
+		uc.Log.WithError(err).Error("Failed to update review helpful count")
+		return utils.WrapMessageAsError(constants.FailedRemoveReviewVote, err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to commit transaction for review vote removal")
+		return utils.WrapMessageAsError(constants.FailedRemoveReviewVote, err)
+	}
+
+	return nil
+}
+
+func (uc *ReviewUseCase) findOwnReview(tx *gorm.DB, productID uuid.UUID, reviewID uuid.UUID, userID uuid.UUID) (*entity.ProductReview, error) {
+	review, err := uc.ProductReviewRepository.FindReviewById(tx, reviewID)
+	if err != nil {
+		uc.Log.WithError(err).Error("Failed to find review by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetReviews, err)
+	}
+
+	if review == nil || review.ProductID != productID || review.UserID != userID {
+		return nil, utils.WrapMessageAsError(constants.ReviewNotFound)
+	}
+
+	return review, nil
+}
+
+func (uc *ReviewUseCase) refreshProductRating(tx *gorm.DB, productID uuid.UUID) (*entity.Product, error) {
+	distribution, err := uc.ProductReviewRepository.CountRatings(tx, productID)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductRating, err)
+	}
+
+	average, count := summarizeRatings(distribution)
+	if err := tx.Model(&entity.Product{}).Where("id = ?", productID).UpdateColumns(map[string]any{
+		"rating_average": average,
+		"rating_count":   count,
+	}).Error; err != nil {
+		uc.Log.WithError(err).Error("Failed to update product rating")
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductRating, err)
+	}
+
+	product, err := uc.ProductRepository.FindProductById(tx, productID)
+	if err != nil {
+		return nil, utils.WrapMessageAsError(constants.FailedUpdateProductRating, err)
+	}
+
+	return product, nil
+}
+
+func (uc *ReviewUseCase) reindexProduct(product *entity.Product) {
+	if product == nil {
+		return
+	}
+
+	if err := uc.ElasticsearchUseCase.InsertDocument(product.ID, product); err != nil {
+		uc.Log.WithError(err).Warnf("Failed to reindex product %s after rating update", product.ID)
+	}
+}
+
+func (uc *ReviewUseCase) toReviewResponses(ctx context.Context, reviews []entity.ProductReview) []*model.ReviewResponse {
+	responses := make([]*model.ReviewResponse, 0, len(reviews))
+	for i := range reviews {
+		responses = append(responses, uc.toReviewResponse(ctx, &reviews[i]))
+	}
+	return responses
+}
+
+func (uc *ReviewUseCase) toReviewResponse(ctx context.Context, review *entity.ProductReview) *model.ReviewResponse {
+	response := converter.ToReviewResponse(review)
+	for i, photo := range review.Photos {
+		url, err := uc.MinioUseCase.GetPresignedURL(ctx, model.PresignedURLInput{
+			Bucket:    uc.Viper.GetString("MINIO_BUCKET_REVIEW"),
+			ObjectKey: photo.PhotoObject,
+			Expiry:    int64((time.Hour * 24).Seconds()),
+		})
+		if err != nil {
+			uc.Log.WithError(err).Warn("Failed to get presigned URL for review photo")
+			continue
+		}
+		response.Photos[i].URL = url
+	}
+	return response
+}
+
+func (uc *ReviewUseCase) deletePhotoObject(ctx context.Context, photoObject string) {
+	if err := uc.MinioUseCase.Delete(ctx, uc.Viper.GetString("MINIO_BUCKET_REVIEW"), photoObject); err != nil {
+		uc.Log.WithError(err).Warnf("Failed to delete review photo %s from Minio", photoObject)
+	}
+}
+
+func findPublishedProduct(db *gorm.DB, log *logrus.Logger, productRepository *repository.ProductRepository, productID uuid.UUID) (*entity.Product, error) {
+	product, err := productRepository.FindProductById(db, productID)
+	if err != nil {
+		log.WithError(err).Error("Failed to find product by ID")
+		return nil, utils.WrapMessageAsError(constants.FailedGetProductByID, err)
+	}
+
+	if product == nil || product.Status != model.ProductStatusPublished {
+		return nil, utils.WrapMessageAsError(constants.ProductNotFound)
+	}
+
+	return product, nil
+}
+
+func resetModeration(review *entity.ProductReview) {
+	review.Status = model.ReviewStatusPending
+	review.ModerationNote = ""
+	review.ModeratedBy = nil
+	review.ModeratedAt = nil
+}
+
+func summarizeRatings(distribution map[int]int64) (float64, int64) {
+	var count, sum int64
+	for rating, total := range distribution {
+		count += total
+		sum += int64(rating) * total
+	}
+
+	if count == 0 {
+		return 0, 0
+	}
+
+	return math.Round(float64(sum)/float64(count)*100) / 100, count
+}
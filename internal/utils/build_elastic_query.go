@@ -3,6 +3,7 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"golectro-product/internal/model"
 	"net/url"
 	"strings"
 )
@@ -24,15 +25,26 @@ func BuildElasticQuery(params url.Values) map[string]any {
 	boolQuery := map[string]any{
 		"must":   []map[string]any{},
 		"filter": []map[string]any{},
+		"must_not": []map[string]any{
+			{
+				"terms": map[string]any{
+					"status.keyword": []string{model.ProductStatusDraft, model.ProductStatusInReview, model.ProductStatusArchived},
+				},
+			},
+		},
 	}
 
 	if name := params.Get("name"); name != "" {
+		fields := []string{"name"}
+		if lang := normalizeLocale(params.Get("lang")); lang != "" {
+			fields = append(fields, fmt.Sprintf("translations.%s.name^2", lang))
+		}
+
 		boolQuery["must"] = append(boolQuery["must"].([]map[string]any), map[string]any{
-			"match": map[string]any{
-				"name": map[string]any{
-					"query":     name,
-					"fuzziness": "AUTO",
-				},
+			"multi_match": map[string]any{
+				"query":     name,
+				"fields":    fields,
+				"fuzziness": "AUTO",
 			},
 		})
 	}
@@ -63,12 +75,14 @@ func BuildElasticQuery(params url.Values) map[string]any {
 		})
 	}
 
+	// Price filters stay in major units as they were before prices were
+	// indexed in minor units, so they are scaled to match the stored amounts.
 	if price := params.Get("price"); price != "" {
 		var pVal float64
 		fmt.Sscanf(price, "%f", &pVal)
 		boolQuery["filter"] = append(boolQuery["filter"].([]map[string]any), map[string]any{
 			"term": map[string]any{
-				"price": pVal,
+				"price": MinorUnits(pVal, model.BaseCurrency),
 			},
 		})
 	}
@@ -77,17 +91,39 @@ func BuildElasticQuery(params url.Values) map[string]any {
 	if min := params.Get("min_price"); min != "" {
 		var minVal float64
 		fmt.Sscanf(min, "%f", &minVal)
-		priceRange["gte"] = minVal
+		priceRange["gte"] = MinorUnits(minVal, model.BaseCurrency)
 	}
 	if max := params.Get("max_price"); max != "" {
 		var maxVal float64
 		fmt.Sscanf(max, "%f", &maxVal)
-		priceRange["lte"] = maxVal
+		priceRange["lte"] = MinorUnits(maxVal, model.BaseCurrency)
 	}
 	if len(priceRange) > 0 {
 		boolQuery["filter"] = append(boolQuery["filter"].([]map[string]any), map[string]any{
 			"range": map[string]any{
-				"price": priceRange,
+				"effective_price": priceRange,
+			},
+		})
+	}
+
+	if dropped := params.Get("price_dropped"); dropped == "true" || dropped == "1" {
+		boolQuery["filter"] = append(boolQuery["filter"].([]map[string]any), map[string]any{
+			"range": map[string]any{
+				"price_dropped_at": map[string]any{
+					"gte": fmt.Sprintf("now-%dd", model.LowestPriceWindowDays),
+				},
+			},
+		})
+	}
+
+	if rating := params.Get("min_rating"); rating != "" {
+		var ratingVal float64
+		fmt.Sscanf(rating, "%f", &ratingVal)
+		boolQuery["filter"] = append(boolQuery["filter"].([]map[string]any), map[string]any{
+			"range": map[string]any{
+				"rating_average": map[string]any{
+					"gte": ratingVal,
+				},
 			},
 		})
 	}
@@ -97,9 +133,13 @@ func BuildElasticQuery(params url.Values) map[string]any {
 		if err := json.Unmarshal([]byte(specs), &specsMap); err == nil {
 			shouldClauses := []map[string]any{}
 			for k, v := range specsMap {
+				field := fmt.Sprintf("specs.%s", k)
+				if _, ok := v.(string); ok {
+					field += ".keyword"
+				}
 				shouldClauses = append(shouldClauses, map[string]any{
 					"term": map[string]any{
-						fmt.Sprintf("specs.%s.keyword", k): v,
+						field: v,
 					},
 				})
 			}
@@ -110,7 +150,7 @@ func BuildElasticQuery(params url.Values) map[string]any {
 		}
 	}
 
-	sortField := "price"
+	sortField := "effective_price"
 	sortOrder := "asc"
 	if s := params.Get("sort"); s != "" {
 		parts := strings.Split(s, ":")
@@ -119,6 +159,12 @@ func BuildElasticQuery(params url.Values) map[string]any {
 			sortOrder = parts[1]
 		}
 	}
+	if sortField == "price" {
+		sortField = "effective_price"
+	}
+	if sortField == "rating" {
+		sortField = "rating_average"
+	}
 
 	query := map[string]any{
 		"from": from,
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+)
+
+func ApplyDiscount(price int64, campaign *entity.PriceCampaign) int64 {
+	discounted := price
+	switch campaign.DiscountType {
+	case model.DiscountTypePercentage:
+		discounted = price - (price*campaign.Value+model.PercentageDiscountMax/2)/model.PercentageDiscountMax
+	case model.DiscountTypeFixed:
+		discounted = price - campaign.Value
+	case model.DiscountTypeSalePrice:
+		discounted = campaign.Value
+	}
+
+	return max(0, min(price, discounted))
+}
+
+func ResolveEffectivePrice(price int64, campaigns []entity.PriceCampaign) (int64, *entity.PriceCampaign) {
+	effective := price
+	var winner *entity.PriceCampaign
+
+	for i := range campaigns {
+		candidate := ApplyDiscount(price, &campaigns[i])
+		if winner == nil ||
+			campaigns[i].Priority > winner.Priority ||
+			(campaigns[i].Priority == winner.Priority && candidate < effective) {
+			effective = candidate
+			winner = &campaigns[i]
+		}
+	}
+
+	return effective, winner
+}
@@ -10,6 +10,11 @@ import (
 )
 
 func GetHTTPStatusCode(err error) int {
+	var se *statusError
+	if errors.As(err, &se) {
+		return se.statusCode
+	}
+
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return http.StatusNotFound
 	}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"math/bits"
+
+	"golang.org/x/image/draw"
+)
+
+func ContentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// PerceptualHash computes a 64-bit difference hash: the image is shrunk to
+// 9x8 grayscale and each bit records whether a pixel is brighter than its
+// right-hand neighbour, so re-encodes and resizes of a photo hash alike.
+func PerceptualHash(img image.Image) uint64 {
+	small := image.NewGray(image.Rect(0, 0, 9, 8))
+	draw.ApproxBiLinear.Scale(small, small.Bounds(), img, img.Bounds(), draw.Src, nil)
+
+	var hash uint64
+	for y := range 8 {
+		for x := range 8 {
+			hash <<= 1
+			if small.GrayAt(x, y).Y > small.GrayAt(x+1, y).Y {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+// PerceptualHashBandCount splits a hash into bytes. Two hashes within a
+// Hamming distance of PerceptualHashBandCount-1 always share at least one
+// band, so an exact match on any band is a lossless pre-filter.
+const PerceptualHashBandCount = 8
+
+func PerceptualHashBands(hash uint64) [PerceptualHashBandCount]uint8 {
+	var bands [PerceptualHashBandCount]uint8
+	for i := range bands {
+		bands[i] = uint8(hash >> (8 * (PerceptualHashBandCount - 1 - i)))
+	}
+	return bands
+}
+
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"math"
+	"testing"
+
+	"golang.org/x/image/draw"
+)
+
+func TestContentHash(t *testing.T) {
+	if got := ContentHash(nil); got != "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855" {
+		t.Fatalf("ContentHash(nil) = %s", got)
+	}
+	if got := ContentHash([]byte("abc")); got != "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad" {
+		t.Fatalf("ContentHash(abc) = %s", got)
+	}
+}
+
+func TestHammingDistance(t *testing.T) {
+	tests := []struct {
+		a, b uint64
+		want int
+	}{
+		{0, 0, 0},
+		{0, math.MaxUint64, 64},
+		{0b1011, 0b0001, 2},
+		{1 << 63, 1, 2},
+	}
+
+	for _, tt := range tests {
+		if got := HammingDistance(tt.a, tt.b); got != tt.want {
+			t.Fatalf("HammingDistance(%b, %b) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestPerceptualHashBands(t *testing.T) {
+	got := PerceptualHashBands(0x0102030405060708)
+	want := [PerceptualHashBandCount]uint8{1, 2, 3, 4, 5, 6, 7, 8}
+	if got != want {
+		t.Fatalf("PerceptualHashBands() = %v, want %v", got, want)
+	}
+}
+
+// Two hashes within PerceptualHashBandCount-1 bits must share a band, or the
+// indexed lookup in FindSimilar would miss them.
+func TestPerceptualHashBandsShareABandWithinDistance(t *testing.T) {
+	hash := uint64(0xA5A5_5A5A_F0F0_0F0F)
+
+	tests := []struct {
+		name  string
+		flips []int
+	}{
+		{"identical", nil},
+		{"one bit per band but one", []int{0, 8, 16, 24, 32, 40, 48}},
+		{"all in one band", []int{56, 57, 58, 59, 60, 61, 62}},
+		{"near-duplicate distance", []int{1, 9, 17, 25, 33, 41}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := hash
+			for _, bit := range tt.flips {
+				other ^= 1 << bit
+			}
+
+			a, b := PerceptualHashBands(hash), PerceptualHashBands(other)
+			for i := range a {
+				if a[i] == b[i] {
+					return
+				}
+			}
+			t.Fatalf("hashes %d bits apart share no band", HammingDistance(hash, other))
+		})
+	}
+}
+
+func TestPerceptualHashToleratesResizingAndReencoding(t *testing.T) {
+	photo := gradientImage(640, 480)
+	base := PerceptualHash(photo)
+
+	if distance := HammingDistance(base, PerceptualHash(photo)); distance != 0 {
+		t.Fatalf("same image is %d bits away", distance)
+	}
+	if distance := HammingDistance(base, PerceptualHash(resizedImage(photo, 320, 240))); distance > 4 {
+		t.Fatalf("resized copy is %d bits away, want at most 4", distance)
+	}
+	if distance := HammingDistance(base, PerceptualHash(reencodedImage(t, photo, 40))); distance > 4 {
+		t.Fatalf("re-encoded copy is %d bits away, want at most 4", distance)
+	}
+}
+
+func TestPerceptualHashSeparatesDifferentImages(t *testing.T) {
+	distance := HammingDistance(PerceptualHash(gradientImage(640, 480)), PerceptualHash(checkerImage(640, 480)))
+	if distance < 16 {
+		t.Fatalf("different images are only %d bits apart, want at least 16", distance)
+	}
+}
+
+func TestPerceptualHashOfFlatImageIsZero(t *testing.T) {
+	flat := image.NewRGBA(image.Rect(0, 0, 64, 64))
+	draw.Draw(flat, flat.Bounds(), image.NewUniform(color.RGBA{200, 30, 30, 255}), image.Point{}, draw.Src)
+
+	if hash := PerceptualHash(flat); hash != 0 {
+		t.Fatalf("PerceptualHash() = %d, want 0", hash)
+	}
+}
+
+func gradientImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			shade := uint8((x*255/width + y*97/height + (x/40)*23) % 256)
+			img.Set(x, y, color.RGBA{shade, shade / 2, 255 - shade, 255})
+		}
+	}
+	return img
+}
+
+func checkerImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			if (x/71+y/53)%2 == 0 {
+				img.Set(x, y, color.White)
+			} else {
+				img.Set(x, y, color.Black)
+			}
+		}
+	}
+	return img
+}
+
+func resizedImage(src image.Image, width, height int) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Src, nil)
+	return dst
+}
+
+func reencodedImage(t *testing.T, src image.Image, quality int) image.Image {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, src, &jpeg.Options{Quality: quality}); err != nil {
+		t.Fatalf("encode jpeg: %v", err)
+	}
+	img, err := jpeg.Decode(&buf)
+	if err != nil {
+		t.Fatalf("decode jpeg: %v", err)
+	}
+	return img
+}
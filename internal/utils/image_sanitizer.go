@@ -0,0 +1,339 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+)
+
+const (
+	DefaultMaxImagePixels = 40_000_000
+	DefaultMaxGIFFrames   = 300
+)
+
+var (
+	ErrImageMalformed     = errors.New("image is malformed")
+	ErrImageTooLarge      = errors.New("image exceeds the maximum pixel count")
+	ErrImageTooManyFrames = errors.New("animated image exceeds the maximum frame count")
+	ErrImageDimensions    = errors.New("image dimensions are out of range")
+	ErrImageAspectRatio   = errors.New("image aspect ratio is out of range")
+)
+
+type ImageConstraints struct {
+	MinWidth       int
+	MinHeight      int
+	MaxWidth       int
+	MaxHeight      int
+	MinAspectRatio float64
+	MaxAspectRatio float64
+	MaxPixels      int
+	MaxFrames      int
+}
+
+type SanitizedImage struct {
+	Content        []byte
+	ContentType    string
+	Width          int
+	Height         int
+	ContentHash    string
+	PerceptualHash uint64
+}
+
+// CheckImagePixels reads only the image header and refuses images whose
+// declared dimensions exceed maxPixels, so callers can run it before a full
+// decode allocates the pixel buffer.
+func CheckImagePixels(content []byte, maxPixels int) (image.Config, string, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return image.Config{}, "", fmt.Errorf("%w: %v", ErrImageMalformed, err)
+	}
+
+	if maxPixels <= 0 {
+		maxPixels = DefaultMaxImagePixels
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return image.Config{}, "", ErrImageTooLarge
+	}
+	if format == "gif" {
+		if err := checkGIFFrames(content, maxPixels, DefaultMaxGIFFrames); err != nil {
+			return image.Config{}, "", err
+		}
+	}
+
+	return config, format, nil
+}
+
+func SanitizeImage(content []byte, constraints ImageConstraints) (*SanitizedImage, error) {
+	maxPixels := constraints.MaxPixels
+	if maxPixels <= 0 {
+		maxPixels = DefaultMaxImagePixels
+	}
+
+	config, format, err := CheckImagePixels(content, maxPixels)
+	if err != nil {
+		return nil, err
+	}
+
+	orientation := 1
+	if format == "jpeg" {
+		orientation = jpegOrientation(content)
+	}
+
+	width, height := config.Width, config.Height
+	if orientation >= 5 {
+		width, height = height, width
+	}
+	if err := checkImageBounds(width, height, constraints); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	var decoded image.Image
+	switch format {
+	case "gif":
+		if err := checkGIFFrames(content, maxPixels, constraints.MaxFrames); err != nil {
+			return nil, err
+		}
+		animation, err := gif.DecodeAll(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrImageMalformed, err)
+		}
+		if err := gif.EncodeAll(&buf, animation); err != nil {
+			return nil, err
+		}
+		decoded = animation.Image[0]
+	case "jpeg":
+		img, err := jpeg.Decode(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrImageMalformed, err)
+		}
+		decoded = orientImage(img, orientation)
+		if err := jpeg.Encode(&buf, decoded, &jpeg.Options{Quality: 90}); err != nil {
+			return nil, err
+		}
+	case "png":
+		img, err := png.Decode(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrImageMalformed, err)
+		}
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, err
+		}
+		decoded = img
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %s", ErrImageMalformed, format)
+	}
+
+	return &SanitizedImage{
+		Content:        buf.Bytes(),
+		ContentType:    "image/" + format,
+		Width:          width,
+		Height:         height,
+		ContentHash:    ContentHash(buf.Bytes()),
+		PerceptualHash: PerceptualHash(decoded),
+	}, nil
+}
+
+// checkGIFFrames walks the GIF block structure without decoding any pixel
+// data, so an animation with thousands of frames is refused before
+// gif.DecodeAll allocates a paletted image for each of them.
+func checkGIFFrames(content []byte, maxPixels, maxFrames int) error {
+	if maxFrames <= 0 {
+		maxFrames = DefaultMaxGIFFrames
+	}
+	if len(content) < 13 {
+		return ErrImageMalformed
+	}
+
+	i := 13
+	if flags := content[10]; flags&0x80 != 0 {
+		i += 3 << ((flags & 0x07) + 1)
+	}
+
+	frames, pixels := 0, 0
+	for i < len(content) {
+		switch content[i] {
+		case 0x21:
+			if i+2 > len(content) {
+				return ErrImageMalformed
+			}
+			next, ok := skipGIFSubBlocks(content, i+2)
+			if !ok {
+				return ErrImageMalformed
+			}
+			i = next
+		case 0x2C:
+			if i+10 > len(content) {
+				return ErrImageMalformed
+			}
+			width := int(binary.LittleEndian.Uint16(content[i+5:]))
+			height := int(binary.LittleEndian.Uint16(content[i+7:]))
+			flags := content[i+9]
+
+			frames++
+			pixels += width * height
+			if frames > maxFrames {
+				return ErrImageTooManyFrames
+			}
+			if pixels > maxPixels {
+				return ErrImageTooLarge
+			}
+
+			i += 10
+			if flags&0x80 != 0 {
+				i += 3 << ((flags & 0x07) + 1)
+			}
+			next, ok := skipGIFSubBlocks(content, i+1)
+			if !ok {
+				return ErrImageMalformed
+			}
+			i = next
+		case 0x3B:
+			return nil
+		default:
+			return ErrImageMalformed
+		}
+	}
+
+	return nil
+}
+
+func skipGIFSubBlocks(content []byte, i int) (int, bool) {
+	for i < len(content) {
+		size := int(content[i])
+		i++
+		if size == 0 {
+			return i, true
+		}
+		i += size
+	}
+
+	return i, false
+}
+
+func checkImageBounds(width, height int, constraints ImageConstraints) error {
+	if width < constraints.MinWidth || height < constraints.MinHeight {
+		return ErrImageDimensions
+	}
+	if (constraints.MaxWidth > 0 && width > constraints.MaxWidth) || (constraints.MaxHeight > 0 && height > constraints.MaxHeight) {
+		return ErrImageDimensions
+	}
+
+	ratio := float64(width) / float64(height)
+	if (constraints.MinAspectRatio > 0 && ratio < constraints.MinAspectRatio) || (constraints.MaxAspectRatio > 0 && ratio > constraints.MaxAspectRatio) {
+		return ErrImageAspectRatio
+	}
+
+	return nil
+}
+
+func jpegOrientation(content []byte) int {
+	if len(content) < 4 || content[0] != 0xFF || content[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(content); {
+		if content[i] != 0xFF {
+			return 1
+		}
+		marker := content[i+1]
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+
+		length := int(binary.BigEndian.Uint16(content[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(content) {
+			return 1
+		}
+
+		segment := content[i+4 : end]
+		if marker == 0xE1 && len(segment) > 14 && string(segment[:6]) == "Exif\x00\x00" {
+			return exifOrientation(segment[6:])
+		}
+		i = end
+	}
+
+	return 1
+}
+
+func exifOrientation(tiff []byte) int {
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[offset:]))
+	for n := range count {
+		entry := offset + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			if value := int(order.Uint16(tiff[entry+8:])); value >= 1 && value <= 8 {
+				return value
+			}
+			return 1
+		}
+	}
+
+	return 1
+}
+
+func orientImage(src image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+
+	width, height := bounds.Dx(), bounds.Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := range height {
+		for x := range width {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = width-1-x, y
+			case 3:
+				dx, dy = width-1-x, height-1-y
+			case 4:
+				dx, dy = x, height-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = height-1-y, x
+			case 7:
+				dx, dy = height-1-y, width-1-x
+			case 8:
+				dx, dy = y, width-1-x
+			}
+			copy(dst.Pix[dst.PixOffset(dx, dy):dst.PixOffset(dx, dy)+4], rgba.Pix[rgba.PixOffset(x, y):rgba.PixOffset(x, y)+4])
+		}
+	}
+
+	return dst
+}
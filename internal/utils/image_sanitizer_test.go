@@ -0,0 +1,271 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func TestSanitizeImage(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         []byte
+		constraints     ImageConstraints
+		wantErr         error
+		wantContentType string
+		wantWidth       int
+		wantHeight      int
+	}{
+		{
+			name:            "png",
+			content:         encodePNG(t, 400, 300),
+			wantContentType: "image/png",
+			wantWidth:       400,
+			wantHeight:      300,
+		},
+		{
+			name:            "jpeg",
+			content:         encodeJPEG(t, 400, 300),
+			wantContentType: "image/jpeg",
+			wantWidth:       400,
+			wantHeight:      300,
+		},
+		{
+			name:            "jpeg rotated by exif orientation",
+			content:         withEXIFOrientation(encodeJPEG(t, 400, 300), 6),
+			wantContentType: "image/jpeg",
+			wantWidth:       300,
+			wantHeight:      400,
+		},
+		{
+			name:            "jpeg mirrored by exif orientation",
+			content:         withEXIFOrientation(encodeJPEG(t, 400, 300), 2),
+			wantContentType: "image/jpeg",
+			wantWidth:       400,
+			wantHeight:      300,
+		},
+		{
+			name:            "animated gif",
+			content:         encodeGIF(t, 5, 64, 64),
+			wantContentType: "image/gif",
+			wantWidth:       64,
+			wantHeight:      64,
+		},
+		{
+			name:        "too small",
+			content:     encodePNG(t, 100, 100),
+			constraints: ImageConstraints{MinWidth: 200, MinHeight: 200},
+			wantErr:     ErrImageDimensions,
+		},
+		{
+			name:        "too wide",
+			content:     encodePNG(t, 400, 100),
+			constraints: ImageConstraints{MaxWidth: 300},
+			wantErr:     ErrImageDimensions,
+		},
+		{
+			name:        "bounds apply after exif rotation",
+			content:     withEXIFOrientation(encodeJPEG(t, 400, 300), 6),
+			constraints: ImageConstraints{MaxHeight: 300},
+			wantErr:     ErrImageDimensions,
+		},
+		{
+			name:        "aspect ratio",
+			content:     encodePNG(t, 400, 100),
+			constraints: ImageConstraints{MinAspectRatio: 0.5, MaxAspectRatio: 2},
+			wantErr:     ErrImageAspectRatio,
+		},
+		{
+			name:        "too many pixels",
+			content:     encodePNG(t, 400, 300),
+			constraints: ImageConstraints{MaxPixels: 100_000},
+			wantErr:     ErrImageTooLarge,
+		},
+		{
+			name:    "declared dimensions beyond the default budget",
+			content: withPNGDimensions(encodePNG(t, 10, 10), 50_000, 50_000),
+			wantErr: ErrImageTooLarge,
+		},
+		{
+			name:        "too many gif frames",
+			content:     encodeGIF(t, 6, 16, 16),
+			constraints: ImageConstraints{MaxFrames: 5},
+			wantErr:     ErrImageTooManyFrames,
+		},
+		{
+			name:        "gif frames beyond the pixel budget",
+			content:     encodeGIF(t, 20, 64, 64),
+			constraints: ImageConstraints{MaxPixels: 64 * 64 * 10},
+			wantErr:     ErrImageTooLarge,
+		},
+		{
+			name:    "truncated gif",
+			content: encodeGIF(t, 3, 64, 64)[:60],
+			wantErr: ErrImageMalformed,
+		},
+		{
+			name:    "not an image",
+			content: []byte("%PDF-1.7 definitely not an image"),
+			wantErr: ErrImageMalformed,
+		},
+		{
+			name:    "truncated png",
+			content: encodePNG(t, 400, 300)[:100],
+			wantErr: ErrImageMalformed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SanitizeImage(tt.content, tt.constraints)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("SanitizeImage() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SanitizeImage() error = %v", err)
+			}
+
+			if got.ContentType != tt.wantContentType || got.Width != tt.wantWidth || got.Height != tt.wantHeight {
+				t.Fatalf("SanitizeImage() = %s %dx%d, want %s %dx%d", got.ContentType, got.Width, got.Height, tt.wantContentType, tt.wantWidth, tt.wantHeight)
+			}
+			if got.ContentHash != ContentHash(got.Content) {
+				t.Fatal("SanitizeImage() content hash does not match the sanitized content")
+			}
+
+			config, format, err := image.DecodeConfig(bytes.NewReader(got.Content))
+			if err != nil {
+				t.Fatalf("sanitized content does not decode: %v", err)
+			}
+			if "image/"+format != got.ContentType || config.Width != got.Width || config.Height != got.Height {
+				t.Fatalf("sanitized content is %s %dx%d, want %s %dx%d", format, config.Width, config.Height, got.ContentType, got.Width, got.Height)
+			}
+		})
+	}
+}
+
+func TestSanitizeImageStripsMetadata(t *testing.T) {
+	content := withEXIFOrientation(encodeJPEG(t, 64, 48), 1)
+
+	got, err := SanitizeImage(content, ImageConstraints{})
+	if err != nil {
+		t.Fatalf("SanitizeImage() error = %v", err)
+	}
+	if bytes.Contains(got.Content, []byte("Exif\x00\x00")) {
+		t.Fatal("sanitized content still carries the EXIF segment")
+	}
+}
+
+func TestCheckImagePixels(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   []byte
+		maxPixels int
+		wantErr   error
+	}{
+		{"within budget", encodePNG(t, 100, 100), 10_000, nil},
+		{"over budget", encodePNG(t, 100, 101), 10_000, ErrImageTooLarge},
+		{"default budget", withPNGDimensions(encodePNG(t, 10, 10), 10_000, 10_000), 0, ErrImageTooLarge},
+		{"gif frame budget", encodeGIF(t, 4, 50, 50), 7_500, ErrImageTooLarge},
+		{"malformed", []byte("not an image"), 0, ErrImageMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := CheckImagePixels(tt.content, tt.maxPixels)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckImagePixels() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testPattern(width, height)); err != nil {
+		t.Fatalf("encode png: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func encodeJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, testPattern(width, height), nil); err != nil {
+		t.Fatalf("encode jpeg: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func encodeGIF(t *testing.T, frames, width, height int) []byte {
+	t.Helper()
+
+	animation := &gif.GIF{}
+	for i := range frames {
+		frame := image.NewPaletted(image.Rect(0, 0, width, height), palette.Plan9)
+		frame.SetColorIndex(i%width, 0, uint8(i))
+		animation.Image = append(animation.Image, frame)
+		animation.Delay = append(animation.Delay, 10)
+	}
+
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, animation); err != nil {
+		t.Fatalf("encode gif: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func testPattern(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), uint8(x ^ y), 255})
+		}
+	}
+	return img
+}
+
+// withEXIFOrientation inserts a minimal big-endian EXIF segment holding only
+// the orientation tag right after the JPEG SOI marker.
+func withEXIFOrientation(content []byte, orientation uint16) []byte {
+	var tiff bytes.Buffer
+	tiff.WriteString("MM\x00\x2a")
+	binary.Write(&tiff, binary.BigEndian, uint32(8))
+	binary.Write(&tiff, binary.BigEndian, uint16(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{0x0112, 3})
+	binary.Write(&tiff, binary.BigEndian, uint32(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{orientation, 0})
+	binary.Write(&tiff, binary.BigEndian, uint32(0))
+
+	payload := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	result := append([]byte{}, content[:2]...)
+	result = append(result, segment...)
+	return append(result, content[2:]...)
+}
+
+// withPNGDimensions rewrites the IHDR size and checksum, so only the header
+// claims the new dimensions while the pixel data stays tiny.
+func withPNGDimensions(content []byte, width, height uint32) []byte {
+	result := append([]byte{}, content...)
+	binary.BigEndian.PutUint32(result[16:], width)
+	binary.BigEndian.PutUint32(result[20:], height)
+	binary.BigEndian.PutUint32(result[29:], crc32.ChecksumIEEE(result[12:29]))
+	return result
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"gorm.io/datatypes"
+)
+
+type JSONChange struct {
+	From json.RawMessage `json:"from"`
+	To   json.RawMessage `json:"to"`
+}
+
+func DiffJSON(previous, current datatypes.JSON) (datatypes.JSON, bool) {
+	before := map[string]json.RawMessage{}
+	after := map[string]json.RawMessage{}
+	if len(previous) > 0 {
+		_ = json.Unmarshal(previous, &before)
+	}
+	if len(current) > 0 {
+		_ = json.Unmarshal(current, &after)
+	}
+
+	keys := make([]string, 0, len(before)+len(after))
+	for key := range before {
+		keys = append(keys, key)
+	}
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	changes := make(map[string]JSONChange)
+	for _, key := range keys {
+		from, to := normalizeJSON(before[key]), normalizeJSON(after[key])
+		if !bytes.Equal(from, to) {
+			changes[key] = JSONChange{From: from, To: to}
+		}
+	}
+
+	data, _ := json.Marshal(changes)
+	return datatypes.JSON(data), len(changes) > 0
+}
+
+func normalizeJSON(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage("null")
+	}
+
+	// UseNumber keeps large minor unit amounts exact instead of rounding
+	// them through float64.
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return raw
+	}
+
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return raw
+	}
+
+	return normalized
+}
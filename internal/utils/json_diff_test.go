@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"gorm.io/datatypes"
+)
+
+func TestDiffJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		previous    string
+		current     string
+		want        string
+		wantChanged bool
+	}{
+		{
+			name:        "unchanged",
+			previous:    `{"name":"Laptop","price":1500000}`,
+			current:     `{"price":1500000,"name":"Laptop"}`,
+			want:        `{}`,
+			wantChanged: false,
+		},
+		{
+			name:        "nested key order and whitespace are ignored",
+			previous:    `{"specs":{"ram":"16 GB","cpu":"i7"}}`,
+			current:     `{"specs": {"cpu": "i7", "ram": "16 GB"}}`,
+			want:        `{}`,
+			wantChanged: false,
+		},
+		{
+			name:        "changed value",
+			previous:    `{"name":"Laptop","price":1500000}`,
+			current:     `{"name":"Laptop","price":1250000}`,
+			want:        `{"price":{"from":1500000,"to":1250000}}`,
+			wantChanged: true,
+		},
+		{
+			name:        "added and removed keys",
+			previous:    `{"color":"black"}`,
+			current:     `{"brand_id":"a"}`,
+			want:        `{"brand_id":{"from":null,"to":"a"},"color":{"from":"black","to":null}}`,
+			wantChanged: true,
+		},
+		{
+			name:        "nested change reports the whole value",
+			previous:    `{"specs":{"ram":"16 GB","cpu":"i7"}}`,
+			current:     `{"specs":{"ram":"32 GB","cpu":"i7"}}`,
+			want:        `{"specs":{"from":{"cpu":"i7","ram":"16 GB"},"to":{"cpu":"i7","ram":"32 GB"}}}`,
+			wantChanged: true,
+		},
+		{
+			name:        "first revision",
+			previous:    ``,
+			current:     `{"name":"Laptop"}`,
+			want:        `{"name":{"from":null,"to":"Laptop"}}`,
+			wantChanged: true,
+		},
+		{
+			name:        "large minor unit amounts keep their precision",
+			previous:    `{"price":9007199254740993}`,
+			current:     `{"price":9007199254740995}`,
+			want:        `{"price":{"from":9007199254740993,"to":9007199254740995}}`,
+			wantChanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := DiffJSON(datatypes.JSON(tt.previous), datatypes.JSON(tt.current))
+			if changed != tt.wantChanged {
+				t.Fatalf("DiffJSON() changed = %v, want %v", changed, tt.wantChanged)
+			}
+			assertJSONEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestDiffJSONRoundTrip(t *testing.T) {
+	revisions := []string{
+		`{"name":"Laptop","price":1500000,"specs":{"ram":"16 GB"},"color":"black","brand_id":null}`,
+		`{"name":"Laptop Pro","price":1500000,"specs":{"ram":"32 GB"},"color":"black","brand_id":null}`,
+		`{"name":"Laptop Pro","price":1250000,"specs":{"ram":"32 GB"},"color":null,"brand_id":"a"}`,
+		`{"name":"Laptop Pro","price":9007199254740993,"specs":null,"color":null,"brand_id":"a"}`,
+	}
+
+	for i := 1; i < len(revisions); i++ {
+		previous, current := datatypes.JSON(revisions[i-1]), datatypes.JSON(revisions[i])
+
+		diff, changed := DiffJSON(previous, current)
+		if !changed {
+			t.Fatalf("revision %d: DiffJSON() reported no change", i)
+		}
+
+		var changes map[string]JSONChange
+		if err := json.Unmarshal(diff, &changes); err != nil {
+			t.Fatalf("revision %d: decode diff: %v", i, err)
+		}
+
+		forward := applyJSONChanges(t, previous, changes, func(change JSONChange) json.RawMessage { return change.To })
+		assertJSONEqual(t, forward, revisions[i])
+
+		rollback := applyJSONChanges(t, current, changes, func(change JSONChange) json.RawMessage { return change.From })
+		assertJSONEqual(t, rollback, revisions[i-1])
+
+		if _, changed := DiffJSON(rollback, previous); changed {
+			t.Fatalf("revision %d: rolling back does not restore the previous snapshot", i)
+		}
+	}
+}
+
+// applyJSONChanges replays a diff the way a rollback does: every changed key
+// takes the side picked by value. Snapshots always carry every key, so null
+// is a value rather than a removal.
+func applyJSONChanges(t *testing.T, document datatypes.JSON, changes map[string]JSONChange, value func(JSONChange) json.RawMessage) datatypes.JSON {
+	t.Helper()
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(document, &fields); err != nil {
+		t.Fatalf("decode document: %v", err)
+	}
+
+	for key, change := range changes {
+		fields[key] = value(change)
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatalf("encode document: %v", err)
+	}
+	return datatypes.JSON(data)
+}
+
+func assertJSONEqual(t *testing.T, got datatypes.JSON, want string) {
+	t.Helper()
+
+	if !reflect.DeepEqual(decodeJSONNumbers(t, got), decodeJSONNumbers(t, []byte(want))) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func decodeJSONNumbers(t *testing.T, data []byte) any {
+	t.Helper()
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		t.Fatalf("decode %s: %v", data, err)
+	}
+	return value
+}
@@ -35,3 +35,18 @@ func WrapMessageAsError(msg model.Message, err ...error) error {
 
 	return errors.New(strings.Join(segments, " | "))
 }
+
+type statusError struct {
+	error
+	statusCode int
+}
+
+func (e *statusError) Unwrap() error {
+	return e.error
+}
+
+// WrapMessageWithStatus behaves like WrapMessageAsError but also carries the
+// HTTP status the controller should respond with.
+func WrapMessageWithStatus(statusCode int, msg model.Message, err ...error) error {
+	return &statusError{error: WrapMessageAsError(msg, err...), statusCode: statusCode}
+}
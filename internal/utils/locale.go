@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"golectro-product/internal/model"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ResolveLocale(ctx *gin.Context) string {
+	if lang := normalizeLocale(ctx.Query("lang")); lang != "" {
+		return lang
+	}
+
+	best, bestQuality := "", 0.0
+	for part := range strings.SplitSeq(ctx.GetHeader("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		lang := normalizeLocale(tag)
+		if lang == "" {
+			continue
+		}
+
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				quality = parsed
+			}
+		}
+
+		if quality > bestQuality {
+			best, bestQuality = lang, quality
+		}
+	}
+
+	if best != "" {
+		return best
+	}
+
+	return model.DefaultLocale
+}
+
+func PickLocalized(values map[string]string, locale string) string {
+	if value := values[locale]; value != "" {
+		return value
+	}
+	return values[model.DefaultLocale]
+}
+
+func normalizeLocale(tag string) string {
+	primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	if primary == "in" {
+		primary = model.LocaleID
+	}
+
+	if slices.Contains(model.SupportedLocales, primary) {
+		return primary
+	}
+
+	return ""
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"golectro-product/internal/model"
+	"math"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ResolveCurrency(ctx *gin.Context) string {
+	if currency := NormalizeCurrency(ctx.Query("currency")); currency != "" {
+		return currency
+	}
+	if currency := NormalizeCurrency(ctx.GetHeader("X-Currency")); currency != "" {
+		return currency
+	}
+	return model.BaseCurrency
+}
+
+func NormalizeCurrency(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if _, ok := model.Currencies[code]; ok {
+		return code
+	}
+	return ""
+}
+
+func ToMoney(amount int64, currency string) model.Money {
+	return model.Money{
+		Amount:   amount,
+		Currency: currency,
+		Exponent: model.Currencies[currency].Exponent,
+	}
+}
+
+func MoneyValue(money model.Money) float64 {
+	return float64(money.Amount) / math.Pow10(money.Exponent)
+}
+
+// MinorUnits converts a major unit amount such as 12.50 into the minor units
+// prices are stored in.
+func MinorUnits(value float64, currency string) int64 {
+	return int64(math.Round(value * math.Pow10(model.Currencies[currency].Exponent)))
+}
+
+func ConvertMoney(money model.Money, rate float64, currency string) model.Money {
+	if money.Currency == currency {
+		return money
+	}
+
+	format := model.Currencies[currency]
+	minor := float64(money.Amount) * rate * math.Pow10(format.Exponent-money.Exponent)
+
+	return model.Money{Amount: int64(math.Round(minor)), Currency: currency, Exponent: format.Exponent}
+}
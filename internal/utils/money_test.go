@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"golectro-product/internal/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestNormalizeCurrency(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{"IDR", "IDR"},
+		{" usd ", "USD"},
+		{"Myr", "MYR"},
+		{"EUR", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if got := NormalizeCurrency(tt.code); got != tt.want {
+				t.Fatalf("NormalizeCurrency(%q) = %q, want %q", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveCurrency(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		header string
+		want   string
+	}{
+		{"query wins", "?currency=usd", "SGD", "USD"},
+		{"header", "", "sgd", "SGD"},
+		{"unsupported query falls back to header", "?currency=eur", "MYR", "MYR"},
+		{"base currency", "", "", model.BaseCurrency},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/api/products"+tt.query, nil)
+			if tt.header != "" {
+				ctx.Request.Header.Set("X-Currency", tt.header)
+			}
+
+			if got := ResolveCurrency(ctx); got != tt.want {
+				t.Fatalf("ResolveCurrency() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToMoney(t *testing.T) {
+	got := ToMoney(2_199_900_000, model.CurrencyIDR)
+	want := model.Money{Amount: 2_199_900_000, Currency: model.CurrencyIDR, Exponent: 2}
+	if got != want {
+		t.Fatalf("ToMoney() = %+v, want %+v", got, want)
+	}
+	if value := MoneyValue(got); value != 21_999_000 {
+		t.Fatalf("MoneyValue() = %v, want 21999000", value)
+	}
+}
+
+func TestMinorUnits(t *testing.T) {
+	for value, want := range map[float64]int64{0: 0, 12.5: 1250, 19.99: 1999, 21_999_000: 2_199_900_000} {
+		if got := MinorUnits(value, model.CurrencyIDR); got != want {
+			t.Errorf("MinorUnits(%v) = %d, want %d", value, got, want)
+		}
+	}
+}
+
+func TestConvertMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    model.Money
+		rate     float64
+		currency string
+		want     model.Money
+	}{
+		{
+			name:     "same currency is unchanged",
+			money:    ToMoney(1_500_000, model.CurrencyUSD),
+			rate:     2,
+			currency: model.CurrencyUSD,
+			want:     ToMoney(1_500_000, model.CurrencyUSD),
+		},
+		{
+			name:     "IDR to USD",
+			money:    ToMoney(2_199_900_000, model.CurrencyIDR),
+			rate:     0.00006,
+			currency: model.CurrencyUSD,
+			want:     ToMoney(131_994, model.CurrencyUSD),
+		},
+		{
+			name:     "USD to IDR",
+			money:    ToMoney(129_999, model.CurrencyUSD),
+			rate:     16_250,
+			currency: model.CurrencyIDR,
+			want:     ToMoney(2_112_483_750, model.CurrencyIDR),
+		},
+		{
+			name:     "rounds half away from zero to the minor unit",
+			money:    ToMoney(3, model.CurrencySGD),
+			rate:     0.5,
+			currency: model.CurrencyMYR,
+			want:     ToMoney(2, model.CurrencyMYR),
+		},
+		{
+			name:     "rounds down below half",
+			money:    ToMoney(1_001, model.CurrencyUSD),
+			rate:     3.4499,
+			currency: model.CurrencyMYR,
+			want:     ToMoney(3_453, model.CurrencyMYR),
+		},
+		{
+			name:     "MYR keeps sen precision",
+			money:    ToMoney(10_000, model.CurrencyUSD),
+			rate:     4.7123,
+			currency: model.CurrencyMYR,
+			want:     ToMoney(47_123, model.CurrencyMYR),
+		},
+		{
+			name:     "zero amount",
+			money:    ToMoney(0, model.CurrencyIDR),
+			rate:     0.00006,
+			currency: model.CurrencyUSD,
+			want:     ToMoney(0, model.CurrencyUSD),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertMoney(tt.money, tt.rate, tt.currency); got != tt.want {
+				t.Fatalf("ConvertMoney() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
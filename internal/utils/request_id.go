@@ -0,0 +1,10 @@
+package utils
+
+import "context"
+
+func RequestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value("requestId").(string); ok {
+		return id
+	}
+	return ""
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Slugify keeps ASCII letters and digits, folding accented letters to their
+// base letter first so "Café" becomes "cafe" rather than "caf".
+func Slugify(value string) string {
+	var builder strings.Builder
+	lastDash := true
+
+	for _, r := range norm.NFD.String(strings.ToLower(strings.TrimSpace(value))) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			lastDash = false
+			continue
+		}
+
+		if !lastDash {
+			builder.WriteRune('-')
+			lastDash = true
+		}
+	}
+
+	return strings.TrimSuffix(builder.String(), "-")
+}
+
+func UniqueSlug(value string, taken func(slug string) (bool, error)) (string, error) {
+	base := Slugify(value)
+	if base == "" {
+		base = "item"
+	}
+
+	slug := base
+	for i := 2; ; i++ {
+		exists, err := taken(slug)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
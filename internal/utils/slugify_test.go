@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"lowercases and joins words", "Gaming Laptop Pro", "gaming-laptop-pro"},
+		{"collapses punctuation", "  ASUS ROG -- Strix G16 (2024)!  ", "asus-rog-strix-g16-2024"},
+		{"folds accents", "Café Crème Über", "cafe-creme-uber"},
+		{"folds composed and decomposed forms alike", "Pok\u00e9mon Poke\u0301mon", "pokemon-pokemon"},
+		{"drops scripts without an ascii form", "ノートパソコン Laptop", "laptop"},
+		{"drops emoji", "Phone 📱 Case", "phone-case"},
+		{"only symbols", "!!! ---", ""},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Slugify(tt.value); got != tt.want {
+				t.Fatalf("Slugify(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueSlug(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		taken []string
+		want  string
+	}{
+		{"free slug", "Gaming Laptop", nil, "gaming-laptop"},
+		{"first collision", "Gaming Laptop", []string{"gaming-laptop"}, "gaming-laptop-2"},
+		{"several collisions", "Gaming Laptop", []string{"gaming-laptop", "gaming-laptop-2", "gaming-laptop-3"}, "gaming-laptop-4"},
+		{"gap in suffixes", "Gaming Laptop", []string{"gaming-laptop", "gaming-laptop-3"}, "gaming-laptop-2"},
+		{"unicode collision", "Café", []string{"cafe"}, "cafe-2"},
+		{"empty name falls back", "ノート", nil, "item"},
+		{"fallback collision", "", []string{"item"}, "item-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taken := make(map[string]bool, len(tt.taken))
+			for _, slug := range tt.taken {
+				taken[slug] = true
+			}
+
+			got, err := UniqueSlug(tt.value, func(slug string) (bool, error) {
+				return taken[slug], nil
+			})
+			if err != nil || got != tt.want {
+				t.Fatalf("UniqueSlug(%q) = %q, %v, want %q, nil", tt.value, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueSlugLookupError(t *testing.T) {
+	lookupErr := errors.New("database unavailable")
+
+	_, err := UniqueSlug("Gaming Laptop", func(string) (bool, error) {
+		return false, lookupErr
+	})
+	if !errors.Is(err, lookupErr) {
+		t.Fatalf("UniqueSlug() error = %v, want %v", err, lookupErr)
+	}
+}
@@ -0,0 +1,214 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"golectro-product/internal/constants"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gorm.io/datatypes"
+)
+
+var specNumberPattern = regexp.MustCompile(`^\s*(-?\d+(?:[.,]\d+)?)`)
+
+func ValidateSpecs(definitions []entity.CategorySpec, specs datatypes.JSON) (datatypes.JSON, model.Message) {
+	if len(definitions) == 0 {
+		return specs, nil
+	}
+
+	values := map[string]any{}
+	if len(specs) > 0 && string(specs) != "null" {
+		if err := json.Unmarshal(specs, &values); err != nil {
+			return nil, constants.InvalidSpecsFormat
+		}
+	}
+
+	normalized := make(map[string]any, len(values))
+	errs := map[string][]string{}
+	addError := func(message model.Message) {
+		for lang, text := range message {
+			errs[lang] = append(errs[lang], text)
+		}
+	}
+
+	known := make(map[string]bool, len(definitions))
+	for _, definition := range definitions {
+		known[definition.Key] = true
+
+		value, ok := values[definition.Key]
+		if !ok || isEmptySpecValue(value) {
+			if definition.Required {
+				addError(formatSpecMessage(constants.SpecFieldRequired, definition.Key))
+			}
+			continue
+		}
+
+		var allowed []string
+		if len(definition.AllowedValues) > 0 {
+			_ = json.Unmarshal(definition.AllowedValues, &allowed)
+		}
+
+		result, template := normalizeSpecValue(definition.Type, value, allowed)
+		if template != nil {
+			addError(formatSpecMessage(template, definition.Key, strings.Join(allowed, ", ")))
+			continue
+		}
+
+		normalized[definition.Key] = result
+	}
+
+	var unknown []string
+	for key := range values {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		addError(formatSpecMessage(constants.SpecFieldUnknown, key))
+	}
+
+	if len(errs) > 0 {
+		message := model.Message{}
+		for lang, list := range errs {
+			message[lang] = strings.Join(list, ", ")
+		}
+		return nil, message
+	}
+
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, constants.InvalidSpecsFormat
+	}
+
+	return datatypes.JSON(data), nil
+}
+
+func normalizeSpecValue(specType string, value any, allowed []string) (any, model.Message) {
+	switch specType {
+	case model.SpecTypeNumber:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case string:
+			match := specNumberPattern.FindStringSubmatch(v)
+			if match == nil {
+				return nil, constants.SpecFieldNumber
+			}
+			number, err := strconv.ParseFloat(strings.Replace(match[1], ",", ".", 1), 64)
+			if err != nil {
+				return nil, constants.SpecFieldNumber
+			}
+			return number, nil
+		}
+		return nil, constants.SpecFieldNumber
+
+	case model.SpecTypeBoolean:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			switch strings.ToLower(strings.TrimSpace(v)) {
+			case "true", "yes", "ya", "1":
+				return true, nil
+			case "false", "no", "tidak", "0":
+				return false, nil
+			}
+		}
+		return nil, constants.SpecFieldBoolean
+
+	case model.SpecTypeList:
+		var items []string
+		switch v := value.(type) {
+		case string:
+			for item := range strings.SplitSeq(v, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+		case []any:
+			for _, raw := range v {
+				item, ok := raw.(string)
+				if !ok {
+					return nil, constants.SpecFieldList
+				}
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+		default:
+			return nil, constants.SpecFieldList
+		}
+
+		for i, item := range items {
+			canonical, ok := matchAllowedValue(item, allowed)
+			if !ok {
+				return nil, constants.SpecFieldOneOf
+			}
+			items[i] = canonical
+		}
+		return items, nil
+
+	default:
+		var text string
+		switch v := value.(type) {
+		case string:
+			text = strings.TrimSpace(v)
+		case float64:
+			text = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			return nil, constants.SpecFieldString
+		}
+
+		canonical, ok := matchAllowedValue(text, allowed)
+		if !ok {
+			return nil, constants.SpecFieldOneOf
+		}
+		return canonical, nil
+	}
+}
+
+func formatSpecMessage(template model.Message, key string, extra ...string) model.Message {
+	message := model.Message{}
+	for lang, text := range template {
+		if strings.Count(text, "%s") > 1 && len(extra) > 0 {
+			message[lang] = fmt.Sprintf(text, key, extra[0])
+		} else {
+			message[lang] = fmt.Sprintf(text, key)
+		}
+	}
+	return message
+}
+
+func matchAllowedValue(value string, allowed []string) (string, bool) {
+	if len(allowed) == 0 {
+		return value, true
+	}
+
+	index := slices.IndexFunc(allowed, func(candidate string) bool {
+		return strings.EqualFold(candidate, value)
+	})
+	if index < 0 {
+		return "", false
+	}
+
+	return allowed[index], true
+}
+
+func isEmptySpecValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []any:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,186 @@
+package utils
+
+import (
+	"encoding/json"
+	"golectro-product/internal/entity"
+	"golectro-product/internal/model"
+	"reflect"
+	"testing"
+
+	"gorm.io/datatypes"
+)
+
+func TestValidateSpecsCoercion(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition entity.CategorySpec
+		value      string
+		want       string
+		wantErr    string
+	}{
+		{"number from number", numberSpec(), `16`, `16`, ""},
+		{"number with unit", numberSpec(), `"16 GB"`, `16`, ""},
+		{"number with decimal comma", numberSpec(), `"1,5 kg"`, `1.5`, ""},
+		{"negative number", numberSpec(), `"-20"`, `-20`, ""},
+		{"number from text", numberSpec(), `"sixteen"`, "", "ram must be a number"},
+		{"number from boolean", numberSpec(), `true`, "", "ram must be a number"},
+
+		{"boolean from boolean", booleanSpec(), `false`, `false`, ""},
+		{"boolean from english", booleanSpec(), `" Yes "`, `true`, ""},
+		{"boolean from indonesian", booleanSpec(), `"tidak"`, `false`, ""},
+		{"boolean from digit", booleanSpec(), `"1"`, `true`, ""},
+		{"boolean from number", booleanSpec(), `1`, "", "ram must be true or false"},
+		{"boolean from text", booleanSpec(), `"maybe"`, "", "ram must be true or false"},
+
+		{"string trims", stringSpec(), `"  Intel i7  "`, `"Intel i7"`, ""},
+		{"string from number", stringSpec(), `2024`, `"2024"`, ""},
+		{"string from fraction", stringSpec(), `1.25`, `"1.25"`, ""},
+		{"string from boolean", stringSpec(), `true`, "", "ram must be a text"},
+
+		{"enum matches case-insensitively", enumSpec("Black", "Silver"), `"silver"`, `"Silver"`, ""},
+		{"enum rejects others", enumSpec("Black", "Silver"), `"Gold"`, "", "ram must be one of [Black, Silver]"},
+
+		{"list from comma separated text", listSpec("WiFi", "Bluetooth", "NFC"), `"wifi, nfc,,"`, `["WiFi","NFC"]`, ""},
+		{"list from array", listSpec("WiFi", "Bluetooth"), `[" bluetooth ", "WIFI"]`, `["Bluetooth","WiFi"]`, ""},
+		{"list without allowed values", listSpec(), `["USB-C", " HDMI "]`, `["USB-C","HDMI"]`, ""},
+		{"list with unknown item", listSpec("WiFi"), `["WiFi","5G"]`, "", "ram must be one of [WiFi]"},
+		{"list with non-text item", listSpec(), `["WiFi", 5]`, "", "ram must be a list"},
+		{"list from number", listSpec(), `5`, "", "ram must be a list"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, message := ValidateSpecs([]entity.CategorySpec{tt.definition}, datatypes.JSON(`{"ram":`+tt.value+`}`))
+			if tt.wantErr != "" {
+				if message == nil || message["en"] != tt.wantErr {
+					t.Fatalf("ValidateSpecs() message = %v, want %q", message, tt.wantErr)
+				}
+				return
+			}
+			if message != nil {
+				t.Fatalf("ValidateSpecs() message = %v", message)
+			}
+			assertSpecs(t, got, `{"ram":`+tt.want+`}`)
+		})
+	}
+}
+
+func TestValidateSpecs(t *testing.T) {
+	definitions := []entity.CategorySpec{
+		{Key: "ram", Type: model.SpecTypeNumber, Required: true},
+		{Key: "color", Type: model.SpecTypeEnum, AllowedValues: datatypes.JSON(`["Black","Silver"]`)},
+	}
+
+	tests := []struct {
+		name        string
+		definitions []entity.CategorySpec
+		specs       string
+		want        string
+		wantErr     map[string]string
+	}{
+		{
+			name:  "valid specs are normalized",
+			specs: `{"ram":"16 GB","color":"black"}`,
+			want:  `{"ram":16,"color":"Black"}`,
+		},
+		{
+			name:  "empty optional value is dropped",
+			specs: `{"ram":8,"color":"  "}`,
+			want:  `{"ram":8}`,
+		},
+		{
+			name:    "missing required value",
+			specs:   `{"color":"Black"}`,
+			wantErr: map[string]string{"en": "ram is required", "id": "ram wajib diisi"},
+		},
+		{
+			name:    "null specs with a required value",
+			specs:   `null`,
+			wantErr: map[string]string{"en": "ram is required"},
+		},
+		{
+			name:  "errors are collected in order",
+			specs: `{"color":"Gold","weight":1,"battery":2}`,
+			wantErr: map[string]string{
+				"en": "ram is required, color must be one of [Black, Silver], battery is not a valid spec for this category, weight is not a valid spec for this category",
+			},
+		},
+		{
+			name:    "specs must be an object",
+			specs:   `["ram"]`,
+			wantErr: map[string]string{"en": "Specs must be a JSON object"},
+		},
+		{
+			name:        "categories without a schema accept anything",
+			definitions: []entity.CategorySpec{},
+			specs:       `{"anything":["goes"]}`,
+			want:        `{"anything":["goes"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := definitions
+			if tt.definitions != nil {
+				schema = tt.definitions
+			}
+
+			got, message := ValidateSpecs(schema, datatypes.JSON(tt.specs))
+			if tt.wantErr != nil {
+				for lang, want := range tt.wantErr {
+					if message[lang] != want {
+						t.Fatalf("ValidateSpecs() message[%s] = %q, want %q", lang, message[lang], want)
+					}
+				}
+				return
+			}
+			if message != nil {
+				t.Fatalf("ValidateSpecs() message = %v", message)
+			}
+			assertSpecs(t, got, tt.want)
+		})
+	}
+}
+
+func assertSpecs(t *testing.T, got datatypes.JSON, want string) {
+	t.Helper()
+
+	var gotValue, wantValue any
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("specs %s are not valid JSON: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("expected specs %s are not valid JSON: %v", want, err)
+	}
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Fatalf("specs = %s, want %s", got, want)
+	}
+}
+
+func numberSpec() entity.CategorySpec {
+	return entity.CategorySpec{Key: "ram", Type: model.SpecTypeNumber}
+}
+
+func booleanSpec() entity.CategorySpec {
+	return entity.CategorySpec{Key: "ram", Type: model.SpecTypeBoolean}
+}
+
+func stringSpec() entity.CategorySpec {
+	return entity.CategorySpec{Key: "ram", Type: model.SpecTypeString}
+}
+
+func enumSpec(allowed ...string) entity.CategorySpec {
+	return entity.CategorySpec{Key: "ram", Type: model.SpecTypeEnum, AllowedValues: specAllowedValues(allowed)}
+}
+
+func listSpec(allowed ...string) entity.CategorySpec {
+	return entity.CategorySpec{Key: "ram", Type: model.SpecTypeList, AllowedValues: specAllowedValues(allowed)}
+}
+
+func specAllowedValues(allowed []string) datatypes.JSON {
+	if len(allowed) == 0 {
+		return nil
+	}
+	data, _ := json.Marshal(allowed)
+	return datatypes.JSON(data)
+}